@@ -0,0 +1,75 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"regenwormen/internal"
+)
+
+// handleAnalyze goes through the decisions of a recorded game and lists the mistakes that cost the most worms
+func handleAnalyze(args []string) (exitCode int) {
+	fs := flag.NewFlagSet("analyze", flag.ExitOnError)
+	all := fs.Bool("all", false, "analyze the AI players too, not only the humans")
+	top := fs.Int("top", 10, "number of mistakes listed, the worst first")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: regenwormen analyze [flags] <game record file>")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+
+		return 2
+	}
+
+	record, err := readRecord(fs.Arg(0))
+	if err != nil {
+		fmt.Println("Cannot read the game record: ", err)
+
+		return 1
+	}
+
+	analysis, err := internal.Analyze(record, *all)
+	if err != nil {
+		fmt.Println("❌ Analysis failed: ", err)
+
+		return 1
+	}
+
+	if len(analysis.Players) == 0 {
+		fmt.Println("No human player in this game, analyze the AI players with -all")
+
+		return 0
+	}
+
+	fmt.Println("=== GAME ANALYSIS ===")
+	fmt.Println()
+	for _, p := range analysis.Players {
+		fmt.Printf("P%d: %d decisions analyzed, %.2f expected worms lost", p, analysis.Decisions[p-1], analysis.Loss[p-1])
+		if hints := record.Players[p-1].Hints; hints > 0 {
+			fmt.Printf(", %d hints asked", hints)
+		}
+		fmt.Println()
+	}
+	fmt.Println()
+
+	if len(analysis.Mistakes) == 0 {
+		fmt.Println("✅ Every decision was the best one")
+
+		return 0
+	}
+
+	fmt.Println("Worst mistakes, valued by the expected worms of the turn:")
+	for i, m := range analysis.Mistakes {
+		if i == *top {
+			fmt.Printf("... and %d smaller mistakes\n", len(analysis.Mistakes)-i)
+
+			break
+		}
+		fmt.Printf("%2d. %s\n", i+1, m)
+	}
+
+	return 0
+}
@@ -2,7 +2,6 @@ package main
 
 import (
 	"bufio"
-	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -12,9 +11,7 @@ import (
 	"regenwormen/pkg/utils"
 )
 
-func handleGameLoop(in *bufio.Reader, game *internal.Game) {
-	var turnStopped bool
-
+func handleGameLoop(in *bufio.Reader, game *internal.Game, hinter internal.AIStrategy) (exit bool) {
 	currentPlayerNr, turnHasJustStarted, err := game.CurrentTurn()
 	if err != nil {
 		fmt.Println("Cannot determine current turn: ", err)
@@ -24,7 +21,7 @@ func handleGameLoop(in *bufio.Reader, game *internal.Game) {
 
 	if turnHasJustStarted {
 		clearScreen()
-		fmt.Printf("=== PLAYER %d TURN ===\n\n", currentPlayerNr)
+		printTurnHeader(currentPlayerNr, game.CurrentPlayer())
 	}
 
 	fmt.Println(game.String())
@@ -35,46 +32,46 @@ func handleGameLoop(in *bufio.Reader, game *internal.Game) {
 	if currentPlayer.IsAI() {
 		// Let AI make all its decisions for this turn
 		for {
-			fmt.Println("🤖 AI thinking whether to roll the dice or not...")
+			fmt.Println(aiThinkingRoll)
 			time.Sleep(500 * time.Millisecond)
-			shouldRoll, explanation := currentPlayer.AiThink(game)
-			fmt.Println("❗️", explanation)
+			shouldRoll, reasoning := currentPlayer.AiThink(game)
+			fmt.Println(aiExplanationMark, reasoning)
 
 			if !shouldRoll {
 				break // AI decides to stop rolling
 			}
 
-			game.Dice.Roll()
-			fmt.Println("Rolling the dice.... 🎲")
-			time.Sleep(500 * time.Millisecond)
-			fmt.Println("Roll:", game.Dice.StringRoll())
-			fmt.Println()
-
-			// If no valid picks available, turn ends with no points
-			if !game.Dice.CanPickAnyFromRoll() {
-				fmt.Println("No symbols can be picked from this roll - turn ends with no points! 🤷")
+			if _, err = game.Roll(); err != nil {
+				log.Printf("invalid AI roll: %v\n", err)
 
 				break
 			}
-
-			// AI picks one symbol
-			fmt.Println("🤖 AI thinking which symbol to pick...")
+			fmt.Println(rollingMessage)
 			time.Sleep(500 * time.Millisecond)
-			symbol, explanation := currentPlayer.AiChoosePick(game)
-			fmt.Println("❗️", explanation)
+			printAIRoll(game)
 
-			// No valid choice, turn ends
-			if symbol < 0 {
+			if game.Phase() == internal.Busted {
 				break
 			}
 
-			if err := game.Dice.Pick(symbol); err != nil {
+			// AI picks one symbol
+			fmt.Println(aiThinkingPick)
+			time.Sleep(500 * time.Millisecond)
+			symbol, reasoning := currentPlayer.AiChoosePick(game)
+			fmt.Println(aiExplanationMark, reasoning)
+
+			if err = game.Pick(symbol); err != nil {
 				log.Printf("invalid AI pick: %v\n", err)
 
 				break
 			}
-			fmt.Println("Picked:", game.Dice.StringPicked())
-			fmt.Println()
+			printAIPicked(game)
+		}
+
+		if game.Phase() == internal.AwaitingRoll {
+			if err = game.StopTurn(); err != nil {
+				log.Printf("invalid AI stop: %v\n", err)
+			}
 		}
 
 		fmt.Println()
@@ -85,65 +82,107 @@ func handleGameLoop(in *bufio.Reader, game *internal.Game) {
 	}
 
 	// Human Turn
-	_ = utils.MustReadString(in, "Press the Enter ↵ key to roll the dice! ")
+	readInput := strings.TrimSpace(utils.MustReadString(in, "Press the Enter ↵ key to roll the dice, or type (save) to pause the game: "))
+	if readInput == "save" {
+		path := readSaveFile(in)
+		if err = saveGame(game, path); err != nil {
+			fmt.Println("Cannot save the game: ", err)
+
+			return
+		}
 
-	for {
-		roll := game.Dice.Roll()
-		cannotPickFromRoll := !game.Dice.CanPickAnyFromRoll()
+		fmt.Printf("Game saved to %s, resume it with --load=%s\n", path, path)
 
-		if game.Dice.IsDone() || cannotPickFromRoll || turnStopped {
-			if len(roll) > 0 && cannotPickFromRoll {
-				fmt.Println("No symbols from last roll could be picked: ", game.Dice.StringRoll())
-			}
+		return true
+	}
 
-			score, noWorms := game.Dice.PickedScore()
-			fmt.Println()
-			if noWorms {
-				fmt.Println("You did not pick any worms. Therefore you did not score any points this turn.")
-			} else {
-				fmt.Printf("Player #%d scored %d points: %s\n", currentPlayerNr, score, game.Dice.StringPicked())
-			}
+	for game.Phase() == internal.AwaitingRoll && !game.Dice().IsDone() {
+		roll, err := game.Roll()
+		if err != nil {
+			fmt.Println("Cannot roll the dice: ", err)
 
-			_ = utils.MustReadString(in, "Press the Enter ↵ key to continue.")
-			game.NextTurn()
-			turnStopped = false
+			break
+		}
 
+		if game.Phase() == internal.Busted {
 			break
 		}
 
-		fmt.Println("Rolling the dice.... 🎲")
-		fmt.Println(game.Dice.String())
+		printHumanRoll(game)
 
-		var picked bool
-		for !picked {
+		for game.Phase() == internal.AwaitingPick {
 			printSymbolPicker(roll, game)
 
-			readInput := strings.TrimSpace(utils.MustReadString(in, " "))
-			if readInput == "s" || readInput == "stop" {
-				turnStopped = true
+			readInput = strings.TrimSpace(utils.MustReadString(in, " "))
+			if isHintCommand(readInput, false) {
+				printHint(game, hinter)
 
-				break
+				continue
 			}
 
-			var inputSymbol internal.Symbol
-			inputSymbol, err = internal.SymbolFrom(readInput)
+			inputSymbol, err := internal.SymbolFrom(readInput)
 			if err != nil {
 				fmt.Println("Please try again: ", err)
 
 				continue
 			}
 
-			err = game.Dice.Pick(inputSymbol)
-			if errors.Is(err, internal.ErrFullyPicked) || errors.Is(err, internal.ErrNoRollYet) {
-				break
-			}
-			if err != nil {
+			if err = game.Pick(inputSymbol); err != nil {
 				fmt.Println("Invalid pick: ", err)
 
 				continue
 			}
+		}
+
+		if game.Dice().IsDone() {
+			break
+		}
+
+		printHumanPicked(game)
+		for {
+			readInput = strings.TrimSpace(utils.MustReadString(in, "Press the Enter ↵ key to roll again, (s)top here or ask for a (h)int: "))
+			if !isHintCommand(readInput, true) {
+				break
+			}
+			printHint(game, hinter)
+		}
+		if readInput == "s" || readInput == "stop" {
+			break
+		}
+	}
 
-			picked = true
+	if game.Phase() == internal.AwaitingRoll {
+		if err = game.StopTurn(); err != nil {
+			fmt.Println("Cannot stop the turn: ", err)
 		}
 	}
+
+	printHumanOutcome(game, currentPlayerNr)
+
+	_ = utils.MustReadString(in, "Press the Enter ↵ key to continue.")
+	game.NextTurn()
+
+	return
+}
+
+// isHintCommand tells whether the input asks for a hint. While picking, "h" is the shortcut of cheese,
+// so that only "hint" and "?" ask for one.
+func isHintCommand(input string, shortcut bool) bool {
+	return input == "hint" || input == "?" || shortcut && input == "h"
+}
+
+// printHint shows what the hint strategy would do in the position of the current player
+func printHint(game *internal.Game, hinter internal.AIStrategy) {
+	reasoning, err := game.Hint(hinter)
+	if err != nil {
+		fmt.Println("No hint available: ", err)
+
+		return
+	}
+
+	advice := reasoning.Action.String()
+	if reasoning.Action == internal.PickAction {
+		advice += " " + reasoning.Symbol.String()
+	}
+	fmt.Printf("💡 %s would %s: %s\n", hinter.Name(), advice, reasoning)
 }
@@ -3,20 +3,31 @@ package main
 import (
 	"bufio"
 	"fmt"
+	"strings"
 
 	"regenwormen/internal"
 	"regenwormen/pkg/utils"
 )
 
-func handleGameMenu(in *bufio.Reader, game *internal.Game) (exit bool) {
-	doStart, answered := utils.MustReadBool(in, "Do you want to start the game?", "yes", "no")
+func handleGameMenu(in *bufio.Reader, game *internal.Game, aiSeats []string) (exit bool) {
+	choice, answered := utils.MustReadChoice(in, "Do you want to start the game?", "yes", "no", "load")
 	if !answered {
 		return
 	}
-	if !doStart {
+	if choice == "no" {
 		fmt.Println("Player has exited the game")
 		return true
 	}
+	if choice == "load" {
+		path := readSaveFile(in)
+		if err := loadGame(game, path); err != nil {
+			fmt.Println("Cannot load the game: ", err)
+			return
+		}
+
+		fmt.Println("Game loaded from", path)
+		return
+	}
 
 	var humanPlayers, aiPlayers int
 	var err error
@@ -29,6 +40,10 @@ func handleGameMenu(in *bufio.Reader, game *internal.Game) (exit bool) {
 			continue
 		}
 
+		if len(aiSeats) > 0 {
+			break
+		}
+
 		aiPlayers, err = utils.MustReadInt(in, "How many AI players? ")
 		if err != nil {
 			fmt.Println("Please enter a valid number:", err)
@@ -39,10 +54,64 @@ func handleGameMenu(in *bufio.Reader, game *internal.Game) (exit bool) {
 		break
 	}
 
-	if err = game.Start(humanPlayers, aiPlayers); err != nil {
+	strategies := aiSeats
+	if len(strategies) == 0 {
+		strategies = readAIStrategies(in, aiPlayers)
+	}
+
+	players, err := internal.NewPlayers(humanPlayers, strategies...)
+	if err == nil {
+		err = game.StartWith(players)
+	}
+	if err != nil {
 		fmt.Println("Cannot start the game: ", err)
 		return
 	}
 
+	fmt.Printf("Dice seed of this game: %d (reuse it with --seed)\n", game.Seed())
+
+	return
+}
+
+// readAIStrategies asks for the strategy of every AI seat, the simple one being the default
+func readAIStrategies(in *bufio.Reader, aiPlayers int) (strategies []string) {
+	for i := 1; i <= aiPlayers; i++ {
+		choice, answered := utils.MustReadChoice(in, fmt.Sprintf("Strategy of AI player %d?", i), internal.AIStrategyNames()...)
+		if !answered {
+			choice = "simple"
+		}
+
+		// Some strategies need an argument, like the command starting a bot
+		if _, err := internal.NewAIStrategy(choice); err != nil {
+			arg := strings.TrimSpace(utils.MustReadString(in, fmt.Sprintf("Argument of the %s strategy: ", choice)))
+			choice += ":" + arg
+			if _, err = internal.NewAIStrategy(choice); err != nil {
+				fmt.Println("Please try again: ", err)
+				i--
+
+				continue
+			}
+		}
+
+		strategies = append(strategies, choice)
+	}
+
+	return
+}
+
+// parseAISeats reads the strategies of a comma separated list, like the AI seats or the tournament entrants
+func parseAISeats(list string) (seats []string, err error) {
+	if strings.TrimSpace(list) == "" {
+		return
+	}
+
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if _, err = internal.NewAIStrategy(name); err != nil {
+			return nil, err
+		}
+		seats = append(seats, name)
+	}
+
 	return
 }
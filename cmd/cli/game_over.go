@@ -6,12 +6,30 @@ import (
 	"regenwormen/internal"
 )
 
-func handleGameOver(game *internal.Game) {
+func handleGameOver(game *internal.Game, recordFile, statsFile string) {
 	clearScreen()
 	fmt.Println("=== GAME OVER ===")
 	fmt.Println()
 
 	printWinner(game)
+	fmt.Printf("Dice seed of this game: %d (reuse it with --seed)\n\n", game.Seed())
+
+	if recordFile != "" {
+		if err := saveRecord(game, recordFile); err != nil {
+			fmt.Println("Cannot record the game: ", err)
+		} else {
+			fmt.Printf("Game recorded to %s, watch it again with: replay %s\n", recordFile, recordFile)
+			fmt.Printf("Learn from your mistakes with: analyze %s\n\n", recordFile)
+		}
+	}
+
+	if statsFile != "" {
+		if err := saveStats(game, statsFile); err != nil {
+			fmt.Println("Cannot save the game stats: ", err)
+		} else {
+			fmt.Printf("Game stats added to %s\n\n", statsFile)
+		}
+	}
 
 	game.Restart()
 }
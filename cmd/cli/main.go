@@ -2,9 +2,14 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"regenwormen/internal"
 )
@@ -14,7 +19,64 @@ func init() {
 }
 
 func main() {
-	game := internal.NewGame()
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "replay":
+			os.Exit(handleReplay(os.Args[2:]))
+		case "analyze":
+			os.Exit(handleAnalyze(os.Args[2:]))
+		case "tournament":
+			os.Exit(handleTournament(os.Args[2:]))
+		case "tune":
+			os.Exit(handleTune(os.Args[2:]))
+		case "train":
+			os.Exit(handleTrain(os.Args[2:]))
+		case "odds":
+			os.Exit(handleOdds(os.Args[2:]))
+		}
+	}
+
+	rulesName := flag.String("rules", internal.DefaultRules.Name, "rule set to play with (mini, classic)")
+	seedFlag := flag.String("seed", "", "seed for the dice, to reproduce a game (a random one when empty)")
+	load := flag.String("load", "", "resume the game saved in the given file")
+	record := flag.String("record", "", "write the record of every finished game to the given file, to replay it later")
+	ai := flag.String("ai", "", fmt.Sprintf("comma separated strategies of the AI players, one per seat (%s)",
+		strings.Join(internal.AIStrategyNames(), ", ")))
+	stats := flag.String("stats", "", "append the stats of every finished game to the given .csv or .jsonl file")
+	hint := flag.String("hint", "expectimax", "strategy giving hints to the human players")
+	flag.Parse()
+
+	aiSeats, err := parseAISeats(*ai)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	seed, err := parseSeed(*seedFlag)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	rules, err := internal.RuleSetByName(*rulesName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	game := internal.NewGame(rules, seed)
+	if *load != "" {
+		if err = loadGame(game, *load); err != nil {
+			log.Fatal(err)
+		}
+	}
+	defer game.Close()
+
+	hinter, err := internal.NewAIStrategy(*hint)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if closer, isCloser := hinter.(io.Closer); isCloser {
+		defer closer.Close()
+	}
+
 	in := bufio.NewReader(os.Stdin)
 
 	clearScreen()
@@ -24,15 +86,33 @@ func main() {
 	for {
 		switch game.State {
 		case internal.GameMenu:
-			if exit := handleGameMenu(in, game); exit {
+			if exit := handleGameMenu(in, game, aiSeats); exit {
 				return
 			}
 		case internal.GameLoop:
-			handleGameLoop(in, game)
+			if exit := handleGameLoop(in, game, hinter); exit {
+				return
+			}
 		case internal.GameOver:
-			handleGameOver(game)
+			handleGameOver(game, *record, *stats)
 		default:
-			log.Fatal("shutting down... unknown game state:", game.State)
+			log.Println("shutting down... unknown game state:", game.State)
+
+			return
 		}
 	}
 }
+
+// parseSeed reads the seed of the dice, picking a random one when none is given
+func parseSeed(flagValue string) (int64, error) {
+	if strings.TrimSpace(flagValue) == "" {
+		return time.Now().UnixNano(), nil
+	}
+
+	seed, err := strconv.ParseInt(strings.TrimSpace(flagValue), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid seed %q: %w", flagValue, err)
+	}
+
+	return seed, nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"regenwormen/internal"
+)
+
+// handleOdds prints the exact odds of the rest of a turn, like the chance to reach 7 with 3 dice left
+// after picking a worm and a bread
+func handleOdds(args []string) (exitCode int) {
+	fs := flag.NewFlagSet("odds", flag.ExitOnError)
+	rulesName := fs.String("rules", internal.DefaultRules.Name, "rule set to play with (mini, classic)")
+	picked := fs.String("picked", "", "comma separated dice picked so far, like worm,worm,bread")
+	dice := fs.Int("dice", -1, "dice left to roll, all the dice not picked by default")
+	policy := fs.String("policy", "most-points", fmt.Sprintf("how a symbol is picked from every roll (%s)",
+		strings.Join(internal.OddsPolicies(), ", ")))
+	stopAt := fs.Int("stop", 0, "stop rolling once the score reaches it with a worm, 0 to roll until no die is left")
+	targets := fs.String("targets", "", "comma separated scores to compute the chance of reaching, stopping once reached unless -stop is set")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: regenwormen odds [flags]")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	rules, err := internal.RuleSetByName(*rulesName)
+	if err != nil {
+		fmt.Println("Cannot compute the odds: ", err)
+
+		return 2
+	}
+
+	question := internal.OddsQuestion{Rules: rules, DiceLeft: *dice, Policy: *policy, StopAt: *stopAt}
+	for _, name := range splitList(*picked) {
+		s, err := internal.SymbolFrom(name)
+		if err != nil {
+			fmt.Println("Cannot compute the odds: ", err)
+
+			return 2
+		}
+		question.Picked = append(question.Picked, s)
+	}
+	for _, target := range splitList(*targets) {
+		score, err := strconv.Atoi(target)
+		if err != nil {
+			fmt.Println("Cannot compute the odds: ", err)
+
+			return 2
+		}
+		question.Targets = append(question.Targets, score)
+	}
+
+	odds, err := internal.ComputeOdds(question)
+	if err != nil {
+		fmt.Println("Cannot compute the odds: ", err)
+
+		return 2
+	}
+
+	if odds.StopAt > 0 {
+		fmt.Printf("Stopping once the score reaches %d with a worm:\n", odds.StopAt)
+	} else {
+		fmt.Println("Rolling out all dice:")
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "Final score\tChance\tAt least\t")
+	atLeast := 1 - odds.BustChance
+	for _, sc := range odds.Scores {
+		if sc.Score == 0 {
+			fmt.Fprintf(w, "no worm\t%.2f%%\t\t\n", 100*sc.Chance)
+			atLeast -= sc.Chance
+
+			continue
+		}
+		fmt.Fprintf(w, "%d\t%.2f%%\t%.2f%%\t\n", sc.Score, 100*sc.Chance, 100*atLeast)
+		atLeast -= sc.Chance
+	}
+	_ = w.Flush()
+
+	fmt.Println()
+	fmt.Printf("Bust chance: %.2f%%\n", 100*odds.BustChance)
+	for _, t := range odds.Targets {
+		if odds.StopAt > 0 {
+			fmt.Printf("Chance of ending with %d or more and a worm: %.2f%%\n", t.Score, 100*t.Chance)
+		} else {
+			fmt.Printf("Chance of reaching %d with a worm, stopping once reached: %.2f%%\n", t.Score, 100*t.Chance)
+		}
+	}
+
+	return 0
+}
+
+// splitList splits a comma separated list, ignoring empty items
+func splitList(list string) (items []string) {
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"regenwormen/internal"
+)
+
+// handleReplay rebuilds a recorded game and prints it the way the players saw it
+func handleReplay(args []string) (exitCode int) {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: regenwormen replay <game record file>")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+
+		return 2
+	}
+
+	record, err := readRecord(fs.Arg(0))
+	if err != nil {
+		fmt.Println("Cannot read the game record: ", err)
+
+		return 1
+	}
+
+	var previous *internal.Action
+	game, err := internal.Replay(record, func(game *internal.Game, a internal.Action) {
+		if previous != nil {
+			printReplayedAction(game, *previous)
+		}
+		printBeforeReplayedAction(game, a, previous)
+		previous = &a
+	})
+	if previous != nil && game != nil {
+		printReplayedAction(game, *previous)
+	}
+
+	if game != nil && game.State == internal.GameOver {
+		fmt.Println("=== GAME OVER ===")
+		fmt.Println()
+		printWinner(game)
+	}
+
+	if err != nil {
+		fmt.Println("❌ Replay failed: ", err)
+
+		return 1
+	}
+
+	fmt.Println("✅ The replayed board and tiles match the recorded final state")
+
+	return 0
+}
+
+// readRecord reads a game record written with --record
+func readRecord(path string) (record internal.Record, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(data, &record)
+
+	return
+}
+
+// printBeforeReplayedAction prints what was shown before the player took the action
+func printBeforeReplayedAction(game *internal.Game, a internal.Action, previous *internal.Action) {
+	player := game.CurrentPlayer()
+	turnStart := previous == nil || previous.Kind == internal.NextTurnAction
+
+	if turnStart {
+		printTurnHeader(a.Player, game.CurrentPlayer())
+		fmt.Println(game.String())
+	}
+
+	if !player.IsAI() {
+		if a.Kind == internal.NextTurnAction {
+			printHumanOutcome(game, a.Player)
+		}
+
+		return
+	}
+
+	// The reasoning shown during the game is the one recorded, the strategy is not asked again
+	if a.Reasoning != nil {
+		if a.Kind == internal.PickAction {
+			fmt.Println(aiThinkingPick)
+		} else {
+			fmt.Println(aiThinkingRoll)
+		}
+		fmt.Println(aiExplanationMark, *a.Reasoning)
+	}
+
+	if a.Kind == internal.NextTurnAction {
+		fmt.Println()
+	}
+}
+
+// printReplayedAction prints what was shown once the player took the action
+func printReplayedAction(game *internal.Game, a internal.Action) {
+	player := game.CurrentPlayer()
+
+	switch a.Kind {
+	case internal.RollAction:
+		if !player.IsAI() {
+			if game.Phase() != internal.Busted {
+				printHumanRoll(game)
+			}
+
+			return
+		}
+
+		fmt.Println(rollingMessage)
+		printAIRoll(game)
+	case internal.PickAction:
+		if !player.IsAI() {
+			if !game.Dice().IsDone() {
+				printHumanPicked(game)
+			}
+
+			return
+		}
+
+		printAIPicked(game)
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"regenwormen/internal"
+	"regenwormen/pkg/utils"
+)
+
+const defaultSaveFile = "regenwormen-save.json"
+
+func saveGame(game *internal.Game, path string) error {
+	data, err := json.MarshalIndent(game, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+// saveStats appends the stats of the finished game to the file
+func saveStats(game *internal.Game, path string) error {
+	stats, err := game.Stats()
+	if err != nil {
+		return err
+	}
+
+	w, err := internal.OpenStatsWriter(path)
+	if err != nil {
+		return err
+	}
+	if err = w.Write(stats); err != nil {
+		_ = w.Close()
+
+		return err
+	}
+
+	return w.Close()
+}
+
+func saveRecord(game *internal.Game, path string) error {
+	data, err := json.MarshalIndent(game.Record(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+func loadGame(game *internal.Game, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if err = json.Unmarshal(data, game); err != nil {
+		return err
+	}
+
+	for i, p := range game.Players() {
+		if err = p.StrategyError(); err != nil {
+			fmt.Printf("⚠️ P%d plays with the simple strategy: %v\n", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+func readSaveFile(in *bufio.Reader) string {
+	path := strings.TrimSpace(utils.MustReadString(in, fmt.Sprintf("File name [%s]: ", defaultSaveFile)))
+	if path == "" {
+		path = defaultSaveFile
+	}
+
+	return path
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"regenwormen/internal"
+)
+
+// handleTournament plays round-robin matches between AI strategies and prints how each of them fared
+func handleTournament(args []string) (exitCode int) {
+	fs := flag.NewFlagSet("tournament", flag.ExitOnError)
+	rulesName := fs.String("rules", internal.DefaultRules.Name, "rule set to play with (mini, classic)")
+	strategies := fs.String("strategies", "easy,simple,expectimax", "comma separated strategies taking part")
+	games := fs.Int("games", 100, "games played by every pair of strategies")
+	seed := fs.Int64("seed", 1, "seed of the first deal, to reproduce a tournament")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: regenwormen tournament [flags]")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	rules, err := internal.RuleSetByName(*rulesName)
+	if err != nil {
+		fmt.Println("Cannot start the tournament: ", err)
+
+		return 2
+	}
+
+	names, err := parseAISeats(*strategies)
+	if err != nil {
+		fmt.Println("Cannot start the tournament: ", err)
+
+		return 2
+	}
+
+	tournament := internal.Tournament{
+		Rules:      rules,
+		Strategies: names,
+		Games:      *games,
+		Seed:       *seed,
+	}
+	result, err := tournament.Run(func(played, total int) {
+		if played%10 == 0 || played == total {
+			fmt.Fprintf(os.Stderr, "\rPlayed %d/%d games", played, total)
+		}
+	})
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		fmt.Println("❌ Tournament failed: ", err)
+
+		return 1
+	}
+
+	printTournamentResult(result)
+
+	return 0
+}
+
+func printTournamentResult(result internal.TournamentResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "Strategy\tGames\tWins\tTies\tWin rate\t95% CI\tAvg worms\tBust rate\t")
+	for _, s := range result.Strategies {
+		low, high := s.WinRateInterval()
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%.1f%%\t%.1f%% - %.1f%%\t%.2f ± %.2f\t%.1f%%\t\n",
+			s.Strategy, s.Games, s.Wins, s.Ties, 100*s.WinRate(), 100*low, 100*high,
+			s.AverageWorms(), s.AverageWormsMargin(), 100*s.BustRate())
+	}
+	_ = w.Flush()
+
+	fmt.Println()
+	for _, m := range result.Matches {
+		fmt.Printf("%s vs %s: %d - %d, %d ties\n", m.A, m.B, m.WinsA, m.WinsB, m.Ties)
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"regenwormen/internal"
+)
+
+// handleTrain learns a policy in self-play, saves it, and compares it with a baseline strategy
+func handleTrain(args []string) (exitCode int) {
+	fs := flag.NewFlagSet("train", flag.ExitOnError)
+	rulesName := fs.String("rules", internal.DefaultRules.Name, "rule set to play with (mini, classic)")
+	players := fs.Int("players", 2, "players of every training game")
+	games := fs.Int("games", 20_000, "training games")
+	alpha := fs.Float64("alpha", 0.02, "smallest learning rate")
+	epsilon := fs.Float64("epsilon", 0.1, "chance of exploring a random decision")
+	seed := fs.Int64("seed", 1, "seed of the exploration and of the deals, to reproduce a training")
+	out := fs.String("out", "policy.json", "file the learned policy is written to")
+	baseline := fs.String("baseline", "simple", "strategy the learned policy is compared with")
+	evalGames := fs.Int("eval", 200, "games played against the baseline after training, 0 to skip")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: regenwormen train [flags]")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	rules, err := internal.RuleSetByName(*rulesName)
+	if err != nil {
+		fmt.Println("Cannot start the training: ", err)
+
+		return 2
+	}
+
+	trainer := internal.Trainer{
+		Rules:   rules,
+		Players: *players,
+		Games:   *games,
+		Alpha:   *alpha,
+		Epsilon: *epsilon,
+		Seed:    *seed,
+	}
+	policy, err := trainer.Run(func(played, total int) {
+		if played%1000 == 0 || played == total {
+			fmt.Fprintf(os.Stderr, "\rTrained on %d/%d games", played, total)
+		}
+	})
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		fmt.Println("❌ Training failed: ", err)
+
+		return 1
+	}
+
+	if err = policy.Save(*out); err != nil {
+		fmt.Println("Cannot save the policy: ", err)
+
+		return 1
+	}
+	fmt.Printf("✅ Learned %d states, saved to %s. Play the policy with --ai=policy:%s\n", len(policy.Values), *out, *out)
+
+	if *evalGames <= 0 {
+		return 0
+	}
+
+	fmt.Println()
+	tournament := internal.Tournament{
+		Rules:      rules,
+		Strategies: []string{"policy:" + *out, *baseline},
+		Games:      *evalGames,
+		Seed:       *seed,
+	}
+	result, err := tournament.Run(nil)
+	if err != nil {
+		fmt.Println("❌ Comparison failed: ", err)
+
+		return 1
+	}
+	printTournamentResult(result)
+
+	return 0
+}
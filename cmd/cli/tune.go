@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"regenwormen/internal"
+)
+
+// handleTune searches parameters of the simple strategy that beat a baseline, and saves the best ones
+func handleTune(args []string) (exitCode int) {
+	fs := flag.NewFlagSet("tune", flag.ExitOnError)
+	rulesName := fs.String("rules", internal.DefaultRules.Name, "rule set to play with (mini, classic)")
+	baseline := fs.String("baseline", "simple", "strategy the tuned parameters must beat")
+	games := fs.Int("games", 200, "games played by every candidate against the baseline")
+	candidates := fs.Int("candidates", 50, "parameter sets to try")
+	seed := fs.Int64("seed", 1, "seed of the search and of the deals, to reproduce a tuning")
+	out := fs.String("out", "simple-params.json", "file the best parameters are written to")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: regenwormen tune [flags]")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	rules, err := internal.RuleSetByName(*rulesName)
+	if err != nil {
+		fmt.Println("Cannot start the tuning: ", err)
+
+		return 2
+	}
+
+	tuner := internal.Tuner{
+		Rules:      rules,
+		Baseline:   *baseline,
+		Games:      *games,
+		Candidates: *candidates,
+		Seed:       *seed,
+	}
+	best, rate, err := tuner.Run(func(candidate int, params internal.SimpleParams, winRate float64) {
+		fmt.Printf("Candidate %d/%d wins %.1f%% against %s: %+v\n", candidate, tuner.Candidates, 100*winRate, tuner.Baseline, params)
+	})
+	if err != nil {
+		fmt.Println("❌ Tuning failed: ", err)
+
+		return 1
+	}
+
+	if err = best.Save(*out); err != nil {
+		fmt.Println("Cannot save the parameters: ", err)
+
+		return 1
+	}
+
+	fmt.Printf("✅ Best parameters win %.1f%% against %s, saved to %s. Play them with --ai=simple:%s\n",
+		100*rate, tuner.Baseline, *out, *out)
+
+	return 0
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+
+	"regenwormen/internal"
+)
+
+// The output of a turn is shared by live games and replays, so that a replay shows what the players saw
+
+const (
+	rollingMessage    = "Rolling the dice.... 🎲"
+	aiThinkingRoll    = "🤖 AI thinking whether to roll the dice or not..."
+	aiThinkingPick    = "🤖 AI thinking which symbol to pick..."
+	aiExplanationMark = "❗️"
+)
+
+func printTurnHeader(playerNr int, player internal.Player) {
+	if player.IsAI() {
+		fmt.Printf("=== PLAYER %d TURN (AI: %s) ===\n\n", playerNr, player.StrategyName())
+
+		return
+	}
+
+	fmt.Printf("=== PLAYER %d TURN ===\n\n", playerNr)
+}
+
+func printAIRoll(game *internal.Game) {
+	fmt.Println("Roll:", game.Dice().StringRoll())
+	fmt.Println()
+
+	// If no valid picks available, the AI busts
+	if game.Phase() == internal.Busted {
+		fmt.Println("No symbols can be picked from this roll - AI busted! 💥")
+	}
+}
+
+func printAIPicked(game *internal.Game) {
+	fmt.Println("Picked:", game.Dice().StringPicked())
+	fmt.Println()
+}
+
+func printHumanRoll(game *internal.Game) {
+	fmt.Println(rollingMessage)
+	fmt.Println(game.Dice().String())
+}
+
+func printHumanPicked(game *internal.Game) {
+	score, _ := game.Dice().PickedScore()
+	fmt.Printf("\nPicked: %s(score %d)\n", game.Dice().StringPicked(), score)
+}
+
+func printHumanOutcome(game *internal.Game, playerNr int) {
+	score, noWorms := game.Dice().PickedScore()
+	fmt.Println()
+	switch {
+	case game.Dice().IsBusted():
+		fmt.Println("No symbols from last roll could be picked: ", game.Dice().StringRoll())
+		fmt.Println("You busted! 💥 Your top tile goes back to the board.")
+	case noWorms:
+		fmt.Println("You did not pick any worms. You busted! 💥 Your top tile goes back to the board.")
+	case game.Phase() == internal.Busted:
+		fmt.Printf("You scored %d points, but there is no tile to take. You busted! 💥\n", score)
+	default:
+		fmt.Printf("Player #%d scored %d points: %s\n", playerNr, score, game.Dice().StringPicked())
+	}
+}
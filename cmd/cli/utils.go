@@ -12,10 +12,10 @@ import (
 func printSymbolPicker(roll []internal.Symbol, game *internal.Game) {
 	printed := map[internal.Symbol]struct{}{}
 
-	fmt.Print("\nPick a symbol or (s)top here: ")
+	fmt.Print("\nPick a symbol here: ")
 	var i int
 	for _, rollSymbol := range roll {
-		if !game.Dice.CanPick(rollSymbol) {
+		if !game.Dice().CanPick(rollSymbol) {
 			continue
 		}
 
@@ -36,38 +36,40 @@ func printSymbolPicker(roll []internal.Symbol, game *internal.Game) {
 		fmt.Print(utils.MustEncloseCharAtIndex(utils.RemoveEmojis(strings.ToLower(rollSymbol.String())), firstCharIndex))
 		i++
 	}
+	fmt.Print(", or ask for a (hint)")
 }
 
 func printWinner(game *internal.Game) {
-	players, err := game.FinalScores()
-	if err != nil {
+	if _, err := game.FinalScores(); err != nil {
 		log.Fatal(err)
 	}
 
-	iWinner := -1
-	wormsWinner := 0
-	tie := false
-
-	for i, p := range players {
-		playerWorms, playerTiles := p.Score()
-		fmt.Printf("P%d captured %d worms with tiles:", i+1, playerWorms)
-		for _, tv := range playerTiles {
-			fmt.Printf(" [%d]", tv)
+	standings := game.Standings()
+	for _, s := range standings {
+		fmt.Printf("%d. P%d captured %d worms with tiles:", s.Rank, s.Player, s.Worms)
+		for _, t := range s.Tiles {
+			fmt.Printf(" [%d]", t.Value)
 		}
 		fmt.Println()
+	}
+
+	if len(standings) == 0 {
+		return
+	}
 
-		if playerWorms > wormsWinner {
-			wormsWinner = playerWorms
-			iWinner = i
-			tie = false
-		} else if playerWorms == wormsWinner {
-			tie = true
+	for i, p := range game.Players() {
+		if hints := p.Hints(); hints > 0 {
+			fmt.Printf("P%d asked for %d hints\n", i+1, hints)
 		}
 	}
 
-	if tie {
+	winner := standings[0]
+	switch {
+	case winner.Tied:
 		fmt.Println("TIE! 🤝")
-	} else {
-		fmt.Printf("PLAYER #%d WINS! 🎉\n\n", iWinner+1)
+	case winner.TieBroken:
+		fmt.Printf("PLAYER #%d WINS on the highest tile [%d]! 🎉\n\n", winner.Player, winner.HighestTile)
+	default:
+		fmt.Printf("PLAYER #%d WINS! 🎉\n\n", winner.Player)
 	}
 }
@@ -0,0 +1,116 @@
+// Command sim plays AI-only games of regenwormen in bulk, without any delay or input, and prints how
+// every seat fared.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"regenwormen/internal"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+// run plays the simulation set up by the flags, and returns the exit code
+func run() (exitCode int) {
+	rulesName := flag.String("rules", internal.DefaultRules.Name, "rule set to play with (mini, classic)")
+	players := flag.Int("players", 2, "players of every game")
+	strategies := flag.String("strategies", "simple", fmt.Sprintf(
+		"comma separated strategies of the seats, repeated to fill them all (%s)", strings.Join(internal.AIStrategyNames(), ", ")))
+	games := flag.Int("games", 1000, "games to play")
+	seed := flag.Int64("seed", 1, "seed of the first deal, to reproduce a simulation")
+	workers := flag.Int("workers", runtime.NumCPU(), "games played at the same time")
+	statsFile := flag.String("stats", "", "append the stats of every game to the given .csv or .jsonl file")
+	quiet := flag.Bool("quiet", false, "do not report the progress")
+	flag.Parse()
+
+	rules, err := internal.RuleSetByName(*rulesName)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Cannot start the simulation: ", err)
+
+		return 2
+	}
+
+	if *players <= 0 {
+		fmt.Fprintln(os.Stderr, "Cannot start the simulation: at least one player is needed")
+
+		return 2
+	}
+
+	simulation := internal.Simulation{
+		Rules:      rules,
+		Strategies: seatStrategies(*strategies, *players),
+		Games:      *games,
+		Seed:       *seed,
+		Workers:    *workers,
+	}
+
+	if *statsFile != "" {
+		w, err := internal.OpenStatsWriter(*statsFile)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Cannot save the game stats: ", err)
+
+			return 2
+		}
+		defer func() { _ = w.Close() }()
+		simulation.Export = w.Write
+	}
+
+	var progress func(played, total int)
+	if !*quiet {
+		progress = func(played, total int) {
+			if played%100 == 0 || played == total {
+				fmt.Fprintf(os.Stderr, "\rPlayed %d/%d games", played, total)
+			}
+		}
+	}
+
+	start := time.Now()
+	result, err := simulation.Run(progress)
+	if !*quiet {
+		fmt.Fprintln(os.Stderr)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Simulation failed: ", err)
+
+		return 1
+	}
+
+	printResult(result, simulation.WorkerCount(), time.Since(start))
+
+	return 0
+}
+
+// seatStrategies repeats the listed strategies until every seat has one
+func seatStrategies(list string, players int) []string {
+	names := strings.Split(list, ",")
+	seats := make([]string, players)
+	for i := range seats {
+		seats[i] = strings.TrimSpace(names[i%len(names)])
+	}
+
+	return seats
+}
+
+func printResult(result internal.SimulationResult, workers int, elapsed time.Duration) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "Seat\tStrategy\tWins\tTies\tWin rate\t95% CI\tAvg worms\tBust rate\t")
+	for i, s := range result.Seats {
+		low, high := s.WinRateInterval()
+		fmt.Fprintf(w, "P%d\t%s\t%d\t%d\t%.1f%%\t%.1f%% - %.1f%%\t%.2f ± %.2f\t%.1f%%\t\n",
+			i+1, s.Strategy, s.Wins, s.Ties, 100*s.WinRate(), 100*low, 100*high,
+			s.AverageWorms(), s.AverageWormsMargin(), 100*s.BustRate())
+	}
+	_ = w.Flush()
+
+	fmt.Println()
+	fmt.Printf("%d games, %.1f turns per game, played in %v by %d workers (%.0f games/s)\n",
+		result.Games, result.AverageTurns(), elapsed.Round(time.Millisecond), workers, float64(result.Games)/elapsed.Seconds())
+}
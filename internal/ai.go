@@ -1,100 +1,309 @@
 package internal
 
 import (
+	"errors"
 	"fmt"
 	"slices"
+	"strings"
 )
 
-// AIStrategy defines the interface for different AI decision-making strategies
+var ErrUnknownStrategy = errors.New("unknown AI strategy")
+
+// AIStrategy defines the interface for different AI decision-making strategies.
+// Strategies only see a read-only view of the game, so they cannot change it.
 type AIStrategy interface {
-	ShouldRoll(game *Game) (shouldRoll bool, explanation string)
-	ChooseSymbol(game *Game) (symbol Symbol, explanation string)
+	Name() string
+	ShouldRoll(view GameView) (shouldRoll bool, reasoning Reasoning)
+	ChooseSymbol(view GameView) (symbol Symbol, reasoning Reasoning)
 }
 
-// SimpleAIStrategy implements a basic strategy
-type SimpleAIStrategy struct {
-	thresholdScore int
+// strategies holds the factories of the AI strategies players can choose from, by name.
+// A factory gets the argument following the name, as in "bot:./mybot", empty when there is none.
+var (
+	strategies    = map[string]func(arg string) (AIStrategy, error){}
+	strategyNames []string
+)
+
+func init() {
+	RegisterAIStrategy("easy", withoutArg(func() AIStrategy { return NewEasyAIStrategy() }))
+	RegisterAIStrategy("simple", newSimpleFromArg)
+	RegisterAIStrategy("expectimax", withoutArg(func() AIStrategy { return NewExpectimaxAIStrategy() }))
+	RegisterAIStrategy("montecarlo", newMonteCarloFromArg)
+	RegisterAIStrategy("bot", newBotFromArg)
+	RegisterAIStrategy("policy", newPolicyFromArg)
 }
 
-func NewSimpleAIStrategy() *SimpleAIStrategy {
-	return &SimpleAIStrategy{
-		thresholdScore: 5, // arbitrary
+// RegisterAIStrategy makes a strategy available by name, replacing any strategy with the same name
+func RegisterAIStrategy(name string, factory func(arg string) (AIStrategy, error)) {
+	if _, exists := strategies[name]; !exists {
+		strategyNames = append(strategyNames, name)
 	}
+	strategies[name] = factory
 }
 
-func (s *SimpleAIStrategy) ShouldRoll(game *Game) (bool, string) {
-	// If no dice picked yet, always roll
-	if len(game.Dice.picked) == 0 {
-		return true, "First roll of the turn"
+// AIStrategyNames lists the names of the available strategies, in the order they were registered
+func AIStrategyNames() []string {
+	return slices.Clone(strategyNames)
+}
+
+// NewAIStrategy creates the AI strategy with the given name, optionally followed by a colon and an argument
+func NewAIStrategy(name string) (AIStrategy, error) {
+	kind, arg, _ := strings.Cut(name, ":")
+	factory, exists := strategies[kind]
+	if !exists {
+		return nil, fmt.Errorf("%s %w", name, ErrUnknownStrategy)
 	}
 
-	score, noWorms := game.Dice.PickedScore()
+	ai, err := factory(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w: %w", name, ErrUnknownStrategy, err)
+	}
 
-	// If no worms yet, must continue
-	if noWorms {
-		return true, "Must continue rolling - no worms picked yet"
+	return ai, nil
+}
+
+// standInStrategy stands in for a strategy known by its name only: one of a replayed game, which never has to
+// decide, or one that could not be created, like a bot whose command is missing on this machine. It keeps the
+// name, so that the game is saved with it again, and lets the simple strategy decide.
+type standInStrategy struct {
+	name     string
+	err      error // why the strategy could not be created, nil when it was not needed
+	fallback AIStrategy
+}
+
+func newStandInStrategy(name string, err error) *standInStrategy {
+	return &standInStrategy{name: name, err: err, fallback: NewSimpleAIStrategy()}
+}
+
+func (s *standInStrategy) Name() string {
+	return s.name
+}
+
+func (s *standInStrategy) ShouldRoll(view GameView) (bool, Reasoning) {
+	roll, reasoning := s.fallback.ShouldRoll(view)
+	reasoning.Summary = fmt.Sprintf("%s is not available, the %s strategy decides - %s", s.name, s.fallback.Name(), reasoning.Summary)
+
+	return roll, reasoning
+}
+
+func (s *standInStrategy) ChooseSymbol(view GameView) (Symbol, Reasoning) {
+	symbol, reasoning := s.fallback.ChooseSymbol(view)
+	reasoning.Summary = fmt.Sprintf("%s is not available, the %s strategy decides - %s", s.name, s.fallback.Name(), reasoning.Summary)
+
+	return symbol, reasoning
+}
+
+// withoutArg adapts the constructor of a strategy that takes no argument into a factory
+func withoutArg(create func() AIStrategy) func(arg string) (AIStrategy, error) {
+	return func(arg string) (AIStrategy, error) {
+		if arg != "" {
+			return nil, fmt.Errorf("unexpected argument %q", arg)
+		}
+
+		return create(), nil
 	}
+}
+
+// EasyAIStrategy plays like a beginner: it grabs the most common symbol of every roll,
+// and stops as soon as any tile can be taken
+type EasyAIStrategy struct{}
 
-	// Check if current score matches an available tile
-	if game.board.HasTile(score) {
-		return false, fmt.Sprintf("Stopping - can get a tile with score %d", score)
+func NewEasyAIStrategy() *EasyAIStrategy {
+	return &EasyAIStrategy{}
+}
+
+func (s *EasyAIStrategy) Name() string {
+	return "easy"
+}
+
+func (s *EasyAIStrategy) ShouldRoll(view GameView) (bool, Reasoning) {
+	if len(view.Picked) == 0 {
+		return decideRoll(true, "First roll of the turn")
 	}
 
-	// Check if AI can steal from other players decks
-	for i, p := range game.players {
-		if i == game.turn { // Skip current player
+	score, hasWorm := view.PickedScore()
+	if !hasWorm {
+		return decideRoll(true, "Need a worm to score")
+	}
+
+	for i := score; i >= view.Board.Min; i-- {
+		if _, onBoard := view.Board.Tile(i); onBoard {
+			return decideRoll(false, fmt.Sprintf("Stopping - %d is enough for a tile", score))
+		}
+	}
+
+	return decideRoll(true, fmt.Sprintf("Rolling - %d is not enough for a tile", score))
+}
+
+func (s *EasyAIStrategy) ChooseSymbol(view GameView) (Symbol, Reasoning) {
+	best, bestCount := Symbol(-1), 0
+	for _, r := range view.Roll {
+		if !view.CanPick(r) {
 			continue
 		}
-		if top, exists := p.tiles.Top(); exists && top.Value == score {
-			return false, fmt.Sprintf("Stopping - can steal tile with value %d", score)
+		if count := view.CountInRoll(r); count > bestCount {
+			best, bestCount = r, count
 		}
 	}
 
-	// Check if we can get a lower value tile
-	for i := score - 1; i >= game.board.min; i-- {
-		if game.board.HasTile(i) {
-			return false, fmt.Sprintf("Stopping - can get a lower value tile with score %d", i)
+	return decidePick(best, fmt.Sprintf("Picking %s - there are %d of them", best, bestCount))
+}
+
+// SimpleAIStrategy implements a basic strategy, following fixed priorities tuned by its parameters
+type SimpleAIStrategy struct {
+	params  SimpleParams
+	byRules bool   // plays with the default parameters of the rules of every game instead of params
+	source  string // file the parameters were loaded from, empty for the defaults
+}
+
+// NewSimpleAIStrategy creates the strategy playing with the default parameters of the rules of every game
+func NewSimpleAIStrategy() *SimpleAIStrategy {
+	return &SimpleAIStrategy{byRules: true}
+}
+
+func NewSimpleAIStrategyWith(params SimpleParams) *SimpleAIStrategy {
+	return &SimpleAIStrategy{params: params}
+}
+
+// newSimpleFromArg creates the strategy with the parameters of a JSON file, as in "simple:params.json"
+func newSimpleFromArg(arg string) (AIStrategy, error) {
+	if arg == "" {
+		return NewSimpleAIStrategy(), nil
+	}
+
+	params, err := LoadSimpleParams(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SimpleAIStrategy{params: params, source: arg}, nil
+}
+
+func (s *SimpleAIStrategy) Name() string {
+	if s.source != "" {
+		return "simple:" + s.source
+	}
+
+	return "simple"
+}
+
+// Params returns the parameters the strategy plays with under the rules
+func (s *SimpleAIStrategy) Params(rules RuleSet) SimpleParams {
+	if s.byRules {
+		return DefaultSimpleParamsFor(rules)
+	}
+
+	return s.params
+}
+
+func (s *SimpleAIStrategy) ShouldRoll(view GameView) (bool, Reasoning) {
+	// If no dice picked yet, always roll
+	if len(view.Picked) == 0 {
+		return decideRoll(true, "First roll of the turn")
+	}
+
+	score, hasWorm := view.PickedScore()
+	params := s.Params(view.Rules)
+
+	// If no worms yet, must continue
+	if !hasWorm {
+		return decideRoll(true, "Must continue rolling - no worms picked yet")
+	}
+
+	// Check if the score gets a tile, from the board or from other players decks
+	if tile, from, ok := tileFor(view, params, score); ok {
+		switch {
+		case params.RollOnWithDice > 0 && view.DiceLeft() >= params.RollOnWithDice:
+			return decideRoll(true, fmt.Sprintf("Continue rolling - could get tile %d, but %d dice are left",
+				tile.Value, view.DiceLeft()))
+		case from >= 0:
+			return decideRoll(false, fmt.Sprintf("Stopping - can steal tile with value %d", score))
+		case tile.Value == score:
+			return decideRoll(false, fmt.Sprintf("Stopping - can get a tile with score %d", score))
+		default:
+			return decideRoll(false, fmt.Sprintf("Stopping - can get a lower value tile with score %d", tile.Value))
 		}
 	}
 
 	// Continue rolling if score is too low
-	if score < s.thresholdScore {
-		return true, fmt.Sprintf("Continue rolling - score %d is too low (threshold: %d)", score, s.thresholdScore)
+	if score < params.ThresholdScore {
+		return decideRoll(true, fmt.Sprintf("Continue rolling - score %d is too low (threshold: %d)", score, params.ThresholdScore))
 	}
 
 	// Stop if score is high enough but no tiles available
-	return false, fmt.Sprintf("Stopping - scored %d but got no tiles! 🤷", score)
+	return decideRoll(false, fmt.Sprintf("Stopping - scored %d but got no tiles! 🤷", score))
 }
 
-func (s *SimpleAIStrategy) ChooseSymbol(game *Game) (Symbol, string) {
-	// First priority: Pick worms if we don't have any
-	if !slices.Contains(game.Dice.picked, Worm) {
-		if slices.Contains(game.Dice.roll, Worm) {
-			return Worm, "Picking Worm - need at least one worm to score"
-		}
+// tileFor returns the tile the parameters are willing to stop for with the score, and who it would be stolen from
+func tileFor(view GameView, params SimpleParams, score int) (tile Tile, from int, ok bool) {
+	tile, from, ok = view.TileFor(score)
+	if ok && from >= 0 && !params.Steal {
+		tile, ok = view.Board.TileFor(score)
+		from = -1
+	}
+	if ok && from < 0 && tile.Value < score && !params.TakeLower {
+		return Tile{}, -1, false
 	}
 
-	// Second priority: Pick bread (worth 2 points)
-	if slices.Contains(game.Dice.roll, Bread) && game.Dice.CanPick(Bread) {
-		return Bread, "Picking Bread - worth 2 points each"
+	return
+}
+
+func (s *SimpleAIStrategy) ChooseSymbol(view GameView) (Symbol, Reasoning) {
+	params := s.Params(view.Rules)
+
+	// First priority: Pick worms if we don't have any
+	if params.WormFirst && !slices.Contains(view.Picked, Worm) {
+		if slices.Contains(view.Roll, Worm) {
+			return decidePick(Worm, "Picking Worm - need at least one worm to score")
+		}
 	}
 
-	// Third priority: Pick most frequent symbol
-	symbolCounts := make(map[Symbol]int)
-	for _, s := range game.Dice.roll {
-		if game.Dice.CanPick(s) {
-			symbolCounts[s]++
+	// Second priority: Pick the symbol worth the most points, if it is worth more than the valuable points
+	mostValuable, bestPoints := Symbol(-1), params.ValuablePoints
+	for _, s := range view.Roll {
+		if points := view.Points(s); view.CanPick(s) && points > bestPoints {
+			mostValuable, bestPoints = s, points
 		}
 	}
+	if mostValuable >= 0 {
+		return decidePick(mostValuable, fmt.Sprintf("Picking %s - worth %d points each", mostValuable, bestPoints))
+	}
 
+	// Third priority: Pick most frequent symbol, the first one rolled on ties so that the choice is repeatable
 	var bestSymbol Symbol
 	maxCount := 0
-	for sym, count := range symbolCounts {
-		if count > maxCount {
+	for _, sym := range view.Roll {
+		if count := view.CountInRoll(sym); view.CanPick(sym) && count > maxCount {
 			maxCount = count
 			bestSymbol = sym
 		}
 	}
 
-	return bestSymbol, "Picking most frequent symbol to maximize score"
+	return decidePick(bestSymbol, fmt.Sprintf("Picking %s - the most frequent symbol, %d of them", bestSymbol, maxCount))
+}
+
+// playAITurn lets the strategy take the decisions left in the current turn, then moves to the next turn
+func playAITurn(game *Game, ai AIStrategy) (err error) {
+	for !game.phase.IsOver() {
+		switch {
+		case game.phase == AwaitingPick:
+			s, _ := ai.ChooseSymbol(game.View())
+			err = game.Pick(s)
+		case game.dice.IsDone():
+			err = game.StopTurn()
+		default:
+			if roll, _ := ai.ShouldRoll(game.View()); roll {
+				_, err = game.Roll()
+			} else {
+				err = game.StopTurn()
+			}
+		}
+
+		if err != nil {
+			return
+		}
+	}
+	game.NextTurn()
+
+	return
 }
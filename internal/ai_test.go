@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestNewAIStrategy(t *testing.T) {
+	for _, name := range []string{"easy", "simple", "expectimax", "montecarlo", "montecarlo:50", "montecarlo:10ms",
+		"montecarlo:200@42", "montecarlo:10ms@-7", "montecarlo:50,10ms@3"} {
+		t.Run(name, func(t *testing.T) {
+			ai, err := NewAIStrategy(name)
+			if err != nil {
+				t.Fatalf("NewAIStrategy(%q) returned error: %v", name, err)
+			}
+			if ai.Name() != name {
+				t.Errorf("NewAIStrategy(%q).Name() = %q", name, ai.Name())
+			}
+		})
+	}
+
+	for _, name := range []string{"cheater", "simple:fast", "montecarlo:often", "montecarlo:50@often", "montecarlo:50,60", "bot"} {
+		if _, err := NewAIStrategy(name); !errors.Is(err, ErrUnknownStrategy) {
+			t.Errorf("NewAIStrategy(%q) error = %v, want %v", name, err, ErrUnknownStrategy)
+		}
+	}
+
+	want := []string{"easy", "simple", "expectimax", "montecarlo", "bot"}
+	if got := AIStrategyNames(); !slices.Equal(got[:len(want)], want) {
+		t.Errorf("AIStrategyNames() = %v, want %v first", got, want)
+	}
+}
+
+func TestNewPlayers(t *testing.T) {
+	players, err := NewPlayers(1, "easy", "expectimax")
+	if err != nil {
+		t.Fatalf("NewPlayers() returned error: %v", err)
+	}
+
+	var got []string
+	for _, p := range players {
+		got = append(got, p.StrategyName())
+	}
+	if want := []string{"", "easy", "expectimax"}; !slices.Equal(got, want) {
+		t.Errorf("NewPlayers() strategies = %v, want %v", got, want)
+	}
+
+	if _, err = NewPlayers(1, "simple", "cheater"); !errors.Is(err, ErrUnknownStrategy) {
+		t.Errorf("NewPlayers() with an unknown strategy error = %v, want %v", err, ErrUnknownStrategy)
+	}
+}
+
+func TestMixedStrategiesGame(t *testing.T) {
+	players, _ := NewPlayers(0, "easy", "simple", "expectimax", "montecarlo:50")
+	game := NewGame(ClassicRules, 11)
+	if err := game.StartWith(players); err != nil {
+		t.Fatalf("StartWith() returned error: %v", err)
+	}
+
+	for turns := 0; turns < 200 && game.State == GameLoop; turns++ {
+		if err := playAITurn(game, game.CurrentPlayer().ai); err != nil {
+			t.Fatalf("playAITurn() by %s returned error: %v", game.CurrentPlayer().StrategyName(), err)
+		}
+	}
+
+	if game.State != GameOver {
+		t.Errorf("Game should be over, got state %v", game.State)
+	}
+
+	snapshot := game.Snapshot()
+	for i, p := range snapshot.Players {
+		if p.Strategy != players[i].StrategyName() {
+			t.Errorf("Snapshot P%d strategy = %q, want %q", i+1, p.Strategy, players[i].StrategyName())
+		}
+	}
+}
+
+func TestEasyAIStrategy(t *testing.T) {
+	ai := NewEasyAIStrategy()
+	game := newScriptedGame()
+
+	game.dice.roll = []Symbol{Worm, Cheese, Cheese, Bread}
+	if s, _ := ai.ChooseSymbol(game.View()); s != Cheese {
+		t.Errorf("ChooseSymbol() = %v, want the most common %v", s, Cheese)
+	}
+
+	game.dice.roll = nil
+	game.dice.picked = []Symbol{Cheese, Cheese, Worm, Worm}
+	if roll, _ := ai.ShouldRoll(game.View()); roll {
+		t.Errorf("ShouldRoll() with 4 and a worm = true, want false")
+	}
+
+	game.dice.picked = []Symbol{Cheese, Cheese, Cheese}
+	if roll, _ := ai.ShouldRoll(game.View()); !roll {
+		t.Errorf("ShouldRoll() without a worm = false, want true")
+	}
+}
@@ -0,0 +1,150 @@
+package internal
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// mistakeTolerance ignores the rounding differences between equally good decisions
+const mistakeTolerance = 1e-9
+
+// Analysis compares the decisions of a recorded game with the optimal play of a turn, valued in expected worms
+// the same way as the expectimax strategy. Forced decisions, like the first roll of a turn, are not analyzed.
+type Analysis struct {
+	Players   []int     // the analyzed players, from 1
+	Decisions []int     // analyzed decisions, by player index
+	Loss      []float64 // expected worms lost by all the mistakes, by player index
+	Mistakes  []Mistake // worst first
+}
+
+// Mistake is a decision worth fewer expected worms than the best one
+type Mistake struct {
+	Index       int // of the action in the record, from 1
+	Turn        int
+	Chosen      Action
+	Best        Action
+	ChosenValue float64 // expected worm swing of the turn after the chosen decision
+	BestValue   float64
+}
+
+// Loss is the expected worms the mistake lost
+func (m Mistake) Loss() float64 {
+	return m.BestValue - m.ChosenValue
+}
+
+func (m Mistake) String() string {
+	return fmt.Sprintf("T%d P%d %s, best %s: %+.2f worms instead of %+.2f, %.2f lost",
+		m.Turn, m.Chosen.Player, describeAction(m.Chosen), describeAction(m.Best), m.ChosenValue, m.BestValue, m.Loss())
+}
+
+// Analyze replays the record and analyzes the decisions of the human players, or of every player with allPlayers
+func Analyze(r Record, allPlayers bool) (a Analysis, err error) {
+	a.Decisions = make([]int, len(r.Players))
+	a.Loss = make([]float64, len(r.Players))
+	for i, p := range r.Players {
+		if allPlayers || p.Mode == Human {
+			a.Players = append(a.Players, i+1)
+		}
+	}
+
+	index := 0
+	_, err = Replay(r, func(g *Game, action Action) {
+		index++
+		if !slices.Contains(a.Players, action.Player) {
+			return
+		}
+
+		best, bestValue, chosenValue, analyzed := valueDecision(g.View(), action)
+		if !analyzed {
+			return
+		}
+
+		a.Decisions[action.Player-1]++
+		if bestValue-chosenValue > mistakeTolerance {
+			a.Loss[action.Player-1] += bestValue - chosenValue
+			a.Mistakes = append(a.Mistakes, Mistake{
+				Index:       index,
+				Turn:        g.turnNr,
+				Chosen:      action,
+				Best:        best,
+				ChosenValue: chosenValue,
+				BestValue:   bestValue,
+			})
+		}
+	})
+	if err != nil {
+		return a, err
+	}
+
+	slices.SortStableFunc(a.Mistakes, func(x, y Mistake) int {
+		switch {
+		case x.Loss() > y.Loss():
+			return -1
+		case x.Loss() < y.Loss():
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return a, nil
+}
+
+// valueDecision values the action taken in the viewed position against the best one. Decisions without
+// alternatives are not analyzed.
+func valueDecision(view GameView, action Action) (best Action, bestValue, chosenValue float64, analyzed bool) {
+	solver := newViewSolver(view)
+	best = Action{Player: action.Player}
+
+	switch action.Kind {
+	case RollAction, StopAction:
+		if len(view.Picked) == 0 || view.DiceLeft() == 0 {
+			return
+		}
+
+		roll, stop := solver.rollValue(view.Picked), solver.stopValue(view.Picked)
+		best.Kind, bestValue = StopAction, stop
+		if roll > stop {
+			best.Kind, bestValue = RollAction, roll
+		}
+		chosenValue = stop
+		if action.Kind == RollAction {
+			chosenValue = roll
+		}
+	case PickAction:
+		var options []Symbol
+		for _, r := range view.Roll {
+			if view.CanPick(r) && !slices.Contains(options, r) {
+				options = append(options, r)
+			}
+		}
+		if len(options) < 2 {
+			return
+		}
+
+		bestValue = math.Inf(-1)
+		for _, s := range options {
+			v := solver.pickValue(view.Picked, view.Roll, s)
+			if v > bestValue {
+				best.Kind, best.Symbol, bestValue = PickAction, s, v
+			}
+			if s == action.Symbol {
+				chosenValue = v
+			}
+		}
+	default:
+		return
+	}
+
+	return best, bestValue, chosenValue, true
+}
+
+// describeAction names the decision without the player taking it
+func describeAction(a Action) string {
+	if a.Kind == PickAction {
+		return fmt.Sprintf("%s %s", a.Kind, a.Symbol)
+	}
+
+	return a.Kind.String()
+}
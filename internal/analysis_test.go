@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+	game := NewGame(DefaultRules, 4)
+	players, _ := NewPlayers(0, "easy", "expectimax")
+	_ = game.StartWith(players)
+	if err := game.AutoPlay(); err != nil {
+		t.Fatalf("AutoPlay() returned error: %v", err)
+	}
+	record := game.Record()
+
+	if a, err := Analyze(record, false); err != nil || len(a.Players) != 0 || len(a.Mistakes) != 0 {
+		t.Errorf("Analyze() without human players = %+v, %v, want nothing analyzed", a, err)
+	}
+
+	a, err := Analyze(record, true)
+	if err != nil {
+		t.Fatalf("Analyze() returned error: %v", err)
+	}
+	if a.Decisions[0] == 0 || a.Decisions[1] == 0 {
+		t.Errorf("Decisions = %v, want both players analyzed", a.Decisions)
+	}
+	if a.Loss[0] <= 0 || a.Loss[1] > mistakeTolerance {
+		t.Errorf("Loss = %v, want only the easy strategy to lose worms", a.Loss)
+	}
+
+	for i, m := range a.Mistakes {
+		if m.Chosen.Player != 1 || m.Loss() <= 0 {
+			t.Errorf("Mistake %v should be a loss of P1", m)
+		}
+		if i > 0 && m.Loss() > a.Mistakes[i-1].Loss() {
+			t.Errorf("Mistakes should be sorted worst first, got %v after %v", m, a.Mistakes[i-1])
+		}
+		if got := record.Actions[m.Index-1]; got != m.Chosen {
+			t.Errorf("Mistake %v points to action %v", m, got)
+		}
+	}
+
+	record.Actions = record.Actions[:len(record.Actions)-3]
+	if _, err = Analyze(record, true); !errors.Is(err, ErrReplayMismatch) {
+		t.Errorf("Analyze() of a truncated record error = %v, want %v", err, ErrReplayMismatch)
+	}
+}
@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrNotAllAI = errors.New("every player must be an AI to play automatically")
+
+// maxAutoPlayTurns bounds automatic games, as a safety net against strategies that never end their turns
+const maxAutoPlayTurns = 10_000
+
+// AutoPlay plays the game to its end, letting every AI player take its own decisions without any delay
+func (g *Game) AutoPlay() error {
+	for _, p := range g.players {
+		if !p.IsAI() || p.ai == nil {
+			return ErrNotAllAI
+		}
+	}
+
+	for turns := 0; g.State == GameLoop; turns++ {
+		if turns == maxAutoPlayTurns {
+			g.Stop()
+
+			return fmt.Errorf("game stopped after %d turns", turns)
+		}
+
+		if err := playAITurn(g, g.players[g.turn].ai); err != nil {
+			return fmt.Errorf("P%d (%s) at turn %d: %w", g.turn+1, g.players[g.turn].ai.Name(), g.turnNr, err)
+		}
+	}
+
+	return nil
+}
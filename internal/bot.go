@@ -0,0 +1,331 @@
+package internal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+var (
+	ErrBotTimeout     = errors.New("bot did not answer in time")
+	ErrBotProtocol    = errors.New("bot broke the protocol")
+	ErrBotIllegalMove = errors.New("bot chose an illegal move")
+	ErrBotUnavailable = errors.New("bot failed too many times")
+)
+
+// The bot protocol is line based, like UCI in chess. The engine starts the bot process and writes to its
+// standard input, the bot answers on its standard output:
+//
+//	engine: regenwormen 1             the protocol version
+//	bot:    ready [name]              within the timeout
+//	engine: position {json}           the GameView of the decision to take
+//	engine: go roll                   asks whether to roll the dice or stop
+//	bot:    roll [explanation]        or: stop [explanation]
+//	engine: go pick                   asks which symbol of the roll to pick
+//	bot:    pick <symbol> [explanation]
+//	engine: quit                      the bot should exit, as it should when its input ends
+//
+// Symbols are named as in JSON: worm, bread, cucumber, ketchup, cheese, tomato. Empty lists may be null.
+// Lines starting with "info" are ignored, so that bots can log what they think. Any other line written
+// without being asked breaks the protocol, as does a bot that stops reading its input.
+const (
+	botProtocolVersion = 1
+	botTimeout         = 5 * time.Second
+	botMaxRestarts     = 3
+)
+
+// BotAIStrategy asks an external process for its decisions. A bot that does not answer in time or breaks the
+// protocol is restarted for the next decision, a few times at most. Meanwhile, and for illegal moves,
+// the decisions are taken by the fallback strategy, so that a broken bot cannot block the game.
+type BotAIStrategy struct {
+	command  []string
+	timeout  time.Duration
+	fallback AIStrategy
+	process  *botProcess
+	restarts int
+	botName  string // as told by the bot during the handshake
+}
+
+type botProcess struct {
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	lines chan string // closed when the bot output ends
+}
+
+func NewBotAIStrategy(command []string, timeout time.Duration) *BotAIStrategy {
+	if timeout <= 0 {
+		timeout = botTimeout
+	}
+
+	return &BotAIStrategy{
+		command:  command,
+		timeout:  timeout,
+		fallback: NewSimpleAIStrategy(),
+	}
+}
+
+// newBotFromArg creates a bot running the command, as in "bot:python3 mybot.py"
+func newBotFromArg(arg string) (AIStrategy, error) {
+	command := strings.Fields(arg)
+	if len(command) == 0 {
+		return nil, errors.New("missing the command starting the bot")
+	}
+
+	return NewBotAIStrategy(command, botTimeout), nil
+}
+
+func (s *BotAIStrategy) Name() string {
+	return "bot:" + strings.Join(s.command, " ")
+}
+
+func (s *BotAIStrategy) ShouldRoll(view GameView) (bool, Reasoning) {
+	move, explanation, err := s.ask(view, "roll")
+	if err == nil {
+		switch {
+		case move == "roll" && view.DiceLeft() > 0:
+			return decideRoll(true, s.explain(explanation))
+		case move == "stop" && len(view.Picked) > 0:
+			return decideRoll(false, s.explain(explanation))
+		default:
+			err = fmt.Errorf("%w: %s", ErrBotIllegalMove, move)
+		}
+	}
+
+	roll, reasoning := s.fallback.ShouldRoll(view)
+
+	return roll, s.fallbackReasoning(err, reasoning)
+}
+
+func (s *BotAIStrategy) ChooseSymbol(view GameView) (Symbol, Reasoning) {
+	move, explanation, err := s.ask(view, "pick")
+	if err == nil {
+		name, rest, _ := strings.Cut(explanation, " ")
+		var symbol Symbol
+		switch {
+		case move != "pick":
+			err = fmt.Errorf("%w: %s", ErrBotIllegalMove, move)
+		case symbol.UnmarshalText([]byte(name)) != nil || !view.CanPick(symbol):
+			err = fmt.Errorf("%w: pick %s", ErrBotIllegalMove, name)
+		default:
+			return decidePick(symbol, s.explain(rest))
+		}
+	}
+
+	symbol, reasoning := s.fallback.ChooseSymbol(view)
+
+	return symbol, s.fallbackReasoning(err, reasoning)
+}
+
+// Close stops the bot process, if it is running
+func (s *BotAIStrategy) Close() error {
+	if s.process == nil {
+		return nil
+	}
+
+	if err := s.send(time.After(s.timeout), "quit\n"); err != nil {
+		return nil // the bot did not read its input, it is stopped already
+	}
+	s.process.stop()
+	s.process = nil
+
+	return nil
+}
+
+func (s *BotAIStrategy) explain(explanation string) string {
+	if explanation == "" {
+		return fmt.Sprintf("Bot %s decided", s.botName)
+	}
+
+	return fmt.Sprintf("Bot %s: %s", s.botName, explanation)
+}
+
+func (s *BotAIStrategy) fallbackReasoning(err error, reasoning Reasoning) Reasoning {
+	reasoning.Summary = fmt.Sprintf("%v, the %s strategy decides - %s", err, s.fallback.Name(), reasoning.Summary)
+
+	return reasoning
+}
+
+// ask sends the position and the question to the bot, and returns the first word of its answer and the rest
+func (s *BotAIStrategy) ask(view GameView, question string) (move, rest string, err error) {
+	if err = s.start(); err != nil {
+		return
+	}
+
+	if err = s.drain(); err != nil {
+		return
+	}
+
+	position, err := json.Marshal(view)
+	if err != nil {
+		return
+	}
+
+	timeout := time.After(s.timeout)
+	if err = s.send(timeout, "position %s\ngo %s\n", position, question); err != nil {
+		return
+	}
+
+	answer, err := s.readLine(timeout)
+	if err != nil {
+		return
+	}
+
+	move, rest, _ = strings.Cut(answer, " ")
+
+	return move, strings.TrimSpace(rest), nil
+}
+
+// start runs the bot process unless it is running already, and checks that it speaks the protocol
+func (s *BotAIStrategy) start() error {
+	if s.process != nil {
+		return nil
+	}
+	if s.restarts > botMaxRestarts {
+		return ErrBotUnavailable
+	}
+
+	cmd := exec.Command(s.command[0], s.command[1:]...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err = cmd.Start(); err != nil {
+		s.restarts++
+
+		return fmt.Errorf("%w: %w", ErrBotProtocol, err)
+	}
+
+	s.process = &botProcess{cmd: cmd, stdin: stdin, lines: make(chan string)}
+	go s.process.read(stdout)
+
+	timeout := time.After(s.timeout)
+	if err = s.send(timeout, "regenwormen %d\n", botProtocolVersion); err != nil {
+		return err
+	}
+
+	answer, err := s.readLine(timeout)
+	if err != nil {
+		return err
+	}
+
+	ready, name, _ := strings.Cut(answer, " ")
+	if ready != "ready" {
+		s.fail()
+
+		return fmt.Errorf("%w: expected ready, got %q", ErrBotProtocol, answer)
+	}
+
+	s.botName = strings.TrimSpace(name)
+	if s.botName == "" {
+		s.botName = s.command[0]
+	}
+
+	return nil
+}
+
+// send writes to the bot, giving up once the timeout fires, so that a bot that stops reading its input
+// cannot block the game
+func (s *BotAIStrategy) send(timeout <-chan time.Time, format string, args ...any) error {
+	written := make(chan error, 1)
+	stdin := s.process.stdin
+	go func() {
+		_, err := fmt.Fprintf(stdin, format, args...)
+		written <- err
+	}()
+
+	select {
+	case err := <-written:
+		if err != nil {
+			s.fail()
+
+			return fmt.Errorf("%w: %w", ErrBotProtocol, err)
+		}
+
+		return nil
+	case <-timeout:
+		// Stopping the bot closes its input, which ends the pending write
+		s.fail()
+
+		return ErrBotTimeout
+	}
+}
+
+// drain discards the info lines the bot wrote since its last answer. Any other line answers no question,
+// and would be taken for the answer to the next one: the bot broke the protocol.
+func (s *BotAIStrategy) drain() error {
+	for {
+		select {
+		case line, open := <-s.process.lines:
+			if !open {
+				s.fail()
+
+				return fmt.Errorf("%w: the bot exited", ErrBotProtocol)
+			}
+			if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "info") {
+				s.fail()
+
+				return fmt.Errorf("%w: unexpected %q", ErrBotProtocol, line)
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+// readLine waits for the next line of the bot that is not an info line, until the timeout fires
+func (s *BotAIStrategy) readLine(timeout <-chan time.Time) (string, error) {
+	for {
+		select {
+		case line, open := <-s.process.lines:
+			if !open {
+				s.fail()
+
+				return "", fmt.Errorf("%w: the bot exited", ErrBotProtocol)
+			}
+			if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "info") {
+				return line, nil
+			}
+		case <-timeout:
+			s.fail()
+
+			return "", ErrBotTimeout
+		}
+	}
+}
+
+// fail stops a misbehaving bot, to restart it for the next decision
+func (s *BotAIStrategy) fail() {
+	s.process.stop()
+	s.process = nil
+	s.restarts++
+}
+
+func (p *botProcess) read(stdout io.Reader) {
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		p.lines <- scanner.Text()
+	}
+	close(p.lines)
+}
+
+func (p *botProcess) stop() {
+	_ = p.stdin.Close()
+	_ = p.cmd.Process.Kill()
+
+	// Drain the output, so that the reading goroutine ends
+	go func() {
+		for range p.lines {
+		}
+		_ = p.cmd.Wait()
+	}()
+}
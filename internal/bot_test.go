@@ -0,0 +1,176 @@
+package internal
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestBotHelperProcess is not a real test: it is the bot started by the other bot tests,
+// behaving as told by the BOT_BEHAVIOR environment variable
+func TestBotHelperProcess(t *testing.T) {
+	behavior := os.Getenv("BOT_BEHAVIOR")
+	if behavior == "" {
+		return
+	}
+	defer os.Exit(0)
+
+	in := bufio.NewScanner(os.Stdin)
+	in.Buffer(nil, 1<<20)
+	in.Scan()
+	if behavior == "mute" {
+		time.Sleep(time.Minute)
+	}
+	fmt.Println("info starting")
+	fmt.Println("ready helper")
+	if behavior == "deaf" {
+		time.Sleep(time.Minute)
+	}
+
+	for in.Scan() {
+		switch line := in.Text(); {
+		case line == "go roll" && behavior == "chatty":
+			fmt.Println("stop enough for me")
+			fmt.Println("roll one more answer")
+		case line == "go roll":
+			fmt.Println("stop enough for me")
+		case line == "go pick" && behavior == "illegal":
+			fmt.Println("pick tomato")
+		case line == "go pick" && behavior == "crash":
+			os.Exit(1)
+		case line == "go pick":
+			fmt.Println("pick cheese the cheese")
+		case strings.HasPrefix(line, "position {"):
+		case line == "quit":
+			return
+		}
+	}
+}
+
+func newHelperBot(t *testing.T, behavior string) *BotAIStrategy {
+	t.Setenv("BOT_BEHAVIOR", behavior)
+	bot := NewBotAIStrategy([]string{os.Args[0], "-test.run=TestBotHelperProcess"}, 2*time.Second)
+	t.Cleanup(func() { _ = bot.Close() })
+
+	return bot
+}
+
+func TestBotAIStrategy(t *testing.T) {
+	bot := newHelperBot(t, "good")
+	game := newScriptedGame(0, 2, 2, 3, 5, 5)
+	_, _ = game.Roll()
+
+	s, explanation := bot.ChooseSymbol(game.View())
+	if s != Cheese || explanation.Summary != "Bot helper: the cheese" {
+		t.Errorf("ChooseSymbol() = %v, %q, want %v from the bot", s, explanation, Cheese)
+	}
+
+	_ = game.Pick(s)
+	if roll, explanation := bot.ShouldRoll(game.View()); roll || explanation.Summary != "Bot helper: enough for me" {
+		t.Errorf("ShouldRoll() = %v, %q, want false from the bot", roll, explanation)
+	}
+
+	// Stopping before picking anything is illegal
+	game.NextTurn()
+	if roll, explanation := bot.ShouldRoll(game.View()); !roll || !strings.Contains(explanation.Summary, ErrBotIllegalMove.Error()) {
+		t.Errorf("ShouldRoll() at turn start = %v, %q, want the fallback to roll", roll, explanation)
+	}
+}
+
+func TestBotAIStrategyFailures(t *testing.T) {
+	tests := []struct {
+		behavior string
+		wantErr  error
+	}{
+		{"illegal", ErrBotIllegalMove},
+		{"crash", ErrBotProtocol},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.behavior, func(t *testing.T) {
+			bot := newHelperBot(t, tt.behavior)
+			game := newScriptedGame(0, 2, 2, 3, 5, 5)
+			_, _ = game.Roll()
+
+			s, explanation := bot.ChooseSymbol(game.View())
+			if s != Worm || !strings.Contains(explanation.Summary, tt.wantErr.Error()) {
+				t.Errorf("ChooseSymbol() = %v, %q, want the fallback pick of %v after %v", s, explanation, Worm, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBotAIStrategyRestarts(t *testing.T) {
+	bot := newHelperBot(t, "mute")
+	bot.timeout = 50 * time.Millisecond
+	view := newScriptedGame().View()
+
+	for i := 0; i <= botMaxRestarts; i++ {
+		if _, explanation := bot.ShouldRoll(view); !strings.Contains(explanation.Summary, ErrBotTimeout.Error()) {
+			t.Fatalf("ShouldRoll() #%d explanation = %q, want a timeout", i+1, explanation)
+		}
+	}
+
+	start := time.Now()
+	if roll, explanation := bot.ShouldRoll(view); !roll || !strings.Contains(explanation.Summary, ErrBotUnavailable.Error()) {
+		t.Errorf("ShouldRoll() after the restarts = %v, %q, want the fallback without the bot", roll, explanation)
+	}
+	if elapsed := time.Since(start); elapsed > bot.timeout {
+		t.Errorf("ShouldRoll() without the bot took %v", elapsed)
+	}
+	if _, _, err := bot.ask(view, "roll"); !errors.Is(err, ErrBotUnavailable) {
+		t.Errorf("ask() error = %v, want %v", err, ErrBotUnavailable)
+	}
+}
+
+func TestGameCloseStopsBots(t *testing.T) {
+	bot := newHelperBot(t, "good")
+	game := NewGame(DefaultRules, 1)
+	_ = game.StartWith([]Player{NewAIPlayer(bot), NewPlayer(Human)})
+	_, _ = game.CurrentPlayer().AiThink(game)
+	if bot.process == nil {
+		t.Fatal("the bot should run once asked for a decision")
+	}
+
+	if err := game.Close(); err != nil || bot.process != nil {
+		t.Errorf("Close() = %v, bot running %v, want the bot stopped", err, bot.process != nil)
+	}
+	if game.State != GameLoop {
+		t.Errorf("Close() changed the game state to %v", game.State)
+	}
+}
+
+func TestBotAIStrategyUnexpectedLines(t *testing.T) {
+	bot := newHelperBot(t, "chatty")
+	game := newScriptedGame(0, 2, 2, 3, 5, 5)
+	_, _ = game.Roll()
+	_ = game.Pick(Worm)
+
+	if roll, explanation := bot.ShouldRoll(game.View()); roll || explanation.Summary != "Bot helper: enough for me" {
+		t.Fatalf("ShouldRoll() = %v, %q, want false from the bot", roll, explanation)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	if _, explanation := bot.ShouldRoll(game.View()); !strings.Contains(explanation.Summary, ErrBotProtocol.Error()) {
+		t.Errorf("ShouldRoll() after an extra line = %q, want the fallback after %v", explanation, ErrBotProtocol)
+	}
+}
+
+func TestBotAIStrategyStopsReading(t *testing.T) {
+	bot := newHelperBot(t, "deaf")
+	if err := bot.start(); err != nil {
+		t.Fatalf("start() returned error: %v", err)
+	}
+
+	start := time.Now()
+	if err := bot.send(time.After(50*time.Millisecond), "position %s\n", strings.Repeat("x", 1<<20)); !errors.Is(err, ErrBotTimeout) {
+		t.Errorf("send() to a bot that stopped reading error = %v, want %v", err, ErrBotTimeout)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("send() to a bot that stopped reading took %v", elapsed)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"slices"
 	"strings"
+	"time"
 
 	"regenwormen/pkg/utils"
 )
@@ -18,6 +19,7 @@ const (
 	Cucumber
 	Ketchup
 	Cheese
+	Tomato
 
 	DefaultDiceCount = 6
 )
@@ -42,11 +44,32 @@ func (s Symbol) String() string {
 		return "Ketchup 🥫"
 	case Cheese:
 		return "Cheese 🧀"
+	case Tomato:
+		return "Tomato 🍅"
 	default:
 		return "Unknown 🤷🏻‍"
 	}
 }
 
+// Name is the plain lowercase name of the symbol, as in "worm"
+func (s Symbol) Name() string {
+	return strings.ToLower(strings.TrimSpace(utils.RemoveEmojis(s.String())))
+}
+
+func (s Symbol) MarshalText() ([]byte, error) {
+	if s < Worm || s > Tomato {
+		return nil, ErrInvalidSymbol
+	}
+
+	return []byte(s.Name()), nil
+}
+
+func (s *Symbol) UnmarshalText(text []byte) (err error) {
+	*s, err = SymbolFrom(string(text))
+
+	return
+}
+
 func SymbolFrom(s string) (Symbol, error) {
 	if len(s) == 0 {
 		return -1, ErrInvalidSymbol
@@ -67,40 +90,80 @@ func SymbolFrom(s string) (Symbol, error) {
 		return Ketchup, nil
 	case "h":
 		return Cheese, nil
+	case "t":
+		return Tomato, nil
 	default:
 		return -1, fmt.Errorf("%s %w", s, ErrInvalidSymbol)
 	}
 }
 
+// Roller picks which face each die lands on. *rand.Rand satisfies it.
+type Roller interface {
+	Intn(n int) int
+}
+
+// SeededRoller always produces the same throws for the same seed. It counts the throws made,
+// so that a saved game can continue with the same throws it would have had.
+type SeededRoller struct {
+	rnd    *rand.Rand
+	throws int
+}
+
+func NewSeededRoller(seed int64) *SeededRoller {
+	return &SeededRoller{rnd: rand.New(rand.NewSource(seed))}
+}
+
+func (r *SeededRoller) Intn(n int) int {
+	r.throws++
+
+	return r.rnd.Intn(n)
+}
+
+// Throws returns how many throws were made since the roller was seeded
+func (r *SeededRoller) Throws() int {
+	return r.throws
+}
+
+// Skip discards the given number of throws among n faces
+func (r *SeededRoller) Skip(throws, n int) {
+	for i := 0; i < throws; i++ {
+		r.Intn(n)
+	}
+}
+
+// Dice are the dice of a turn: the ones picked so far and the last roll. Only the game rolls and picks them,
+// so that every change goes through the checks of the turn phases.
 type Dice struct {
 	count  int
+	faces  []Symbol
+	points map[Symbol]int
+	roller Roller
 	roll   []Symbol
 	picked []Symbol
 }
 
-func NewDice(count int) *Dice {
-	if count <= 0 {
-		count = DefaultDiceCount
+// NewDice creates the dice for the given rules, unset dice rules taking the default values.
+// A nil roller falls back to a time seeded one.
+func NewDice(rules RuleSet, roller Roller) *Dice {
+	rules = rules.WithDefaults()
+	count, faces, points := rules.DiceCount, rules.Faces, rules.Points
+	if roller == nil {
+		roller = rand.New(rand.NewSource(time.Now().UnixNano()))
 	}
 
-	return &Dice{count: count}
+	return &Dice{count: count, faces: faces, points: points, roller: roller}
 }
 
-func (d *Dice) Reset() {
+func (d *Dice) reset() {
 	d.picked = nil
 	d.roll = nil
 }
 
-func (d *Dice) Roll() []Symbol {
+func (d *Dice) throw() []Symbol {
 	d.roll = nil
 
 	for i := 0; i < d.count-len(d.picked); i++ {
-		throw := rand.Intn(6) - 1
-		if throw < 0 {
-			throw = 0
-		}
-
-		d.roll = append(d.roll, Symbol(throw))
+		d.roll = append(d.roll, d.faces[d.roller.Intn(len(d.faces))])
 	}
 
 	return d.roll
@@ -126,7 +189,12 @@ func (d *Dice) CanPickAnyFromRoll() (can bool) {
 	return
 }
 
-func (d *Dice) Pick(s Symbol) error {
+// IsBusted checks if the last roll left no symbol that can still be picked
+func (d *Dice) IsBusted() bool {
+	return len(d.roll) > 0 && !d.CanPickAnyFromRoll()
+}
+
+func (d *Dice) pick(s Symbol) error {
 	if d.IsDone() {
 		return ErrFullyPicked
 	}
@@ -158,12 +226,18 @@ func (d *Dice) PickedScore() (score int, noWorms bool) {
 		return 0, true
 	}
 
-	breads := utils.CountOccurrences(d.picked, func(ps Symbol) bool { return ps == Bread })
-	score = len(d.picked) - breads + (breads * 2)
+	for _, ps := range d.picked {
+		score += d.points[ps]
+	}
 
 	return
 }
 
+// SymbolPoints returns how many points every die showing the symbol is worth
+func (d *Dice) SymbolPoints(s Symbol) int {
+	return d.points[s]
+}
+
 func (d *Dice) String() string {
 	var sb strings.Builder
 
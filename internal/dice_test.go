@@ -2,58 +2,97 @@ package internal
 
 import (
 	"errors"
+	"slices"
 	"testing"
 )
 
 func TestNewDice(t *testing.T) {
 	tests := []struct {
 		name  string
-		count int
+		rules RuleSet
 		want  int
 	}{
-		{"default count", 0, DefaultDiceCount},
-		{"custom count", 8, 8},
+		{"default count", RuleSet{}, DefaultDiceCount},
+		{"custom count", RuleSet{DiceCount: 8}, 8},
+		{"classic rules", ClassicRules, 8},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := NewDice(tt.count)
+			d := NewDice(tt.rules, nil)
 			if d.count != tt.want {
-				t.Errorf("NewDice(%d).count = %v, want %v", tt.count, d.count, tt.want)
+				t.Errorf("NewDice(%d).count = %v, want %v", tt.rules.DiceCount, d.count, tt.want)
+			}
+			if len(d.faces) == 0 || len(d.points) == 0 {
+				t.Errorf("NewDice() should always have faces and points")
 			}
 		})
 	}
 }
 
 func TestDiceReset(t *testing.T) {
-	d := NewDice(DefaultDiceCount)
+	d := NewDice(DefaultRules, nil)
 	d.roll = []Symbol{Worm, Bread}
 	d.picked = []Symbol{Cucumber}
 
-	d.Reset()
+	d.reset()
 
 	if len(d.roll) != 0 || len(d.picked) != 0 {
-		t.Errorf("Dice.Reset() didn't clear roll and picked slices")
+		t.Errorf("Dice.reset() didn't clear roll and picked slices")
 	}
 }
 
 func TestDiceRoll(t *testing.T) {
-	d := NewDice(6)
+	d := NewDice(RuleSet{DiceCount: 6}, nil)
 	d.picked = []Symbol{Worm, Bread} // 2 dice already picked
 
-	roll := d.Roll()
+	roll := d.throw()
 
 	if len(roll) != 4 { // Should roll 4 dice (6 total - 2 picked)
-		t.Errorf("Dice.Roll() returned %d dice, want %d", len(roll), 4)
+		t.Errorf("Dice.throw() returned %d dice, want %d", len(roll), 4)
 	}
 
 	if len(d.roll) != 4 {
-		t.Errorf("Dice.roll has %d dice after Roll(), want %d", len(d.roll), 4)
+		t.Errorf("Dice.roll has %d dice after throw(), want %d", len(d.roll), 4)
+	}
+}
+
+// scriptedRoller replays a fixed list of face indexes
+type scriptedRoller struct {
+	faces []int
+}
+
+func (r *scriptedRoller) Intn(n int) int {
+	f := r.faces[0] % n
+	r.faces = r.faces[1:]
+
+	return f
+}
+
+func TestDiceRollScripted(t *testing.T) {
+	d := NewDice(DefaultRules, &scriptedRoller{faces: []int{0, 2, 5, 3, 1, 4}})
+
+	roll := d.throw()
+
+	want := []Symbol{Worm, Bread, Cheese, Cucumber, Worm, Ketchup}
+	if !slices.Equal(roll, want) {
+		t.Errorf("Dice.throw() = %v, want %v", roll, want)
+	}
+}
+
+func TestDiceRollSeeded(t *testing.T) {
+	d1 := NewDice(ClassicRules, NewSeededRoller(42))
+	d2 := NewDice(ClassicRules, NewSeededRoller(42))
+
+	for i := 0; i < 10; i++ {
+		if r1, r2 := d1.throw(), d2.throw(); !slices.Equal(r1, r2) {
+			t.Fatalf("Roll #%d with the same seed differs: %v vs %v", i, r1, r2)
+		}
 	}
 }
 
 func TestDiceIsDone(t *testing.T) {
-	d := NewDice(3)
+	d := NewDice(RuleSet{DiceCount: 3}, nil)
 
 	if d.IsDone() {
 		t.Errorf("New dice should not be done")
@@ -67,7 +106,7 @@ func TestDiceIsDone(t *testing.T) {
 }
 
 func TestDiceCanPick(t *testing.T) {
-	d := NewDice(DefaultDiceCount)
+	d := NewDice(DefaultRules, nil)
 	d.picked = []Symbol{Worm}
 
 	if !d.CanPick(Bread) {
@@ -80,7 +119,7 @@ func TestDiceCanPick(t *testing.T) {
 }
 
 func TestDiceCanPickAnyFromRoll(t *testing.T) {
-	d := NewDice(DefaultDiceCount)
+	d := NewDice(DefaultRules, nil)
 
 	// No roll yet
 	if d.CanPickAnyFromRoll() {
@@ -148,10 +187,10 @@ func TestDicePick(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := tt.dice.Pick(tt.pick)
+			err := tt.dice.pick(tt.pick)
 
 			if !errors.Is(err, tt.wantErr) {
-				t.Errorf("Dice.Pick() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Dice.pick() error = %v, wantErr %v", err, tt.wantErr)
 			}
 
 			if len(tt.dice.picked) != tt.wantLen {
@@ -168,6 +207,7 @@ func TestDicePick(t *testing.T) {
 func TestDicePickedScore(t *testing.T) {
 	tests := []struct {
 		name        string
+		rules       RuleSet
 		picked      []Symbol
 		wantScore   int
 		wantNoWorms bool
@@ -190,11 +230,18 @@ func TestDicePickedScore(t *testing.T) {
 			wantScore:   6, // 4 dice - 2 bread + (2 bread * 2) = 6
 			wantNoWorms: false,
 		},
+		{
+			name:        "classic rules",
+			rules:       ClassicRules,
+			picked:      []Symbol{Worm, Worm, Bread, Cucumber, Tomato},
+			wantScore:   20, // 5 + 5 + 5 + 4 + 1
+			wantNoWorms: false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d := NewDice(DefaultDiceCount)
+			d := NewDice(tt.rules, nil)
 			d.picked = tt.picked
 
 			score, noWorms := d.PickedScore()
@@ -220,6 +267,7 @@ func TestSymbolString(t *testing.T) {
 		{Cucumber, "Cucumber 🥒"},
 		{Ketchup, "Ketchup 🥫"},
 		{Cheese, "Cheese 🧀"},
+		{Tomato, "Tomato 🍅"},
 		{Symbol(99), "Unknown 🤷🏻‍"},
 	}
 
@@ -254,6 +302,8 @@ func TestSymbolFrom(t *testing.T) {
 		{"h", Cheese, false, nil},
 		{"H", Cheese, false, nil},
 		{"cheese", Cheese, false, nil},
+		{"t", Tomato, false, nil},
+		{"tomato", Tomato, false, nil},
 		{"", -1, true, func(err error) bool { return err == ErrInvalidSymbol }},
 		{"x", -1, true, func(err error) bool { return err != nil }},
 	}
@@ -275,3 +325,21 @@ func TestSymbolFrom(t *testing.T) {
 		})
 	}
 }
+
+func TestSymbolText(t *testing.T) {
+	for _, s := range ClassicRules.Symbols() {
+		text, err := s.MarshalText()
+		if err != nil {
+			t.Fatalf("%v.MarshalText() returned error: %v", s, err)
+		}
+
+		var got Symbol
+		if err = got.UnmarshalText(text); err != nil || got != s {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", text, got, err, s)
+		}
+	}
+
+	if _, err := Symbol(99).MarshalText(); !errors.Is(err, ErrInvalidSymbol) {
+		t.Errorf("MarshalText() of an unknown symbol error = %v, want %v", err, ErrInvalidSymbol)
+	}
+}
@@ -0,0 +1,132 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Event is a state change that happened during a game. Turn counts from 1 for the whole game,
+// Player is the player number starting from 1.
+type Event interface {
+	fmt.Stringer
+	isEvent()
+}
+
+type RollEvent struct {
+	Turn   int
+	Player int
+	Roll   []Symbol
+}
+
+type PickEvent struct {
+	Turn   int
+	Player int
+	Symbol Symbol
+	Count  int // how many dice showing the symbol were set aside
+}
+
+type TileTakenEvent struct {
+	Turn   int
+	Player int
+	Tile   Tile
+	Score  int // the score of the picked dice, higher than the tile value when a lower tile was taken
+}
+
+type TileStolenEvent struct {
+	Turn   int
+	Player int
+	From   int
+	Tile   Tile
+}
+
+type BustEvent struct {
+	Turn         int
+	Player       int
+	ReturnedTile Tile // the top tile the player put back on the board, if Returned
+	Returned     bool
+	FlippedTile  Tile // the highest board tile turned face down, if Flipped
+	Flipped      bool
+}
+
+type HintEvent struct {
+	Turn     int
+	Player   int
+	Strategy string     // the strategy asked for the hint
+	Advice   ActionKind // roll, stop or pick
+	Symbol   Symbol     // only meaningful for picks
+}
+
+type GameOverEvent struct {
+	Turn      int
+	Standings []Standing
+}
+
+func (RollEvent) isEvent()       {}
+func (PickEvent) isEvent()       {}
+func (TileTakenEvent) isEvent()  {}
+func (TileStolenEvent) isEvent() {}
+func (BustEvent) isEvent()       {}
+func (HintEvent) isEvent()       {}
+func (GameOverEvent) isEvent()   {}
+
+func (e RollEvent) String() string {
+	var sb strings.Builder
+	for _, s := range e.Roll {
+		sb.WriteString(fmt.Sprintf(" [%s]", s))
+	}
+
+	return fmt.Sprintf("T%d P%d rolled%s", e.Turn, e.Player, sb.String())
+}
+
+func (e PickEvent) String() string {
+	return fmt.Sprintf("T%d P%d picked %d x %s", e.Turn, e.Player, e.Count, e.Symbol)
+}
+
+func (e TileTakenEvent) String() string {
+	return fmt.Sprintf("T%d P%d scored %d and took tile [%d] from the board", e.Turn, e.Player, e.Score, e.Tile.Value)
+}
+
+func (e TileStolenEvent) String() string {
+	return fmt.Sprintf("T%d P%d stole tile [%d] from P%d", e.Turn, e.Player, e.Tile.Value, e.From)
+}
+
+func (e BustEvent) String() string {
+	s := fmt.Sprintf("T%d P%d busted", e.Turn, e.Player)
+	if e.Returned {
+		s += fmt.Sprintf(", returned tile [%d]", e.ReturnedTile.Value)
+	}
+	if e.Flipped {
+		s += fmt.Sprintf(", tile [%d] turned face down", e.FlippedTile.Value)
+	}
+
+	return s
+}
+
+func (e HintEvent) String() string {
+	if e.Advice == PickAction {
+		return fmt.Sprintf("T%d P%d asked %s for a hint: %s %s", e.Turn, e.Player, e.Strategy, e.Advice, e.Symbol)
+	}
+
+	return fmt.Sprintf("T%d P%d asked %s for a hint: %s", e.Turn, e.Player, e.Strategy, e.Advice)
+}
+
+func (e GameOverEvent) String() string {
+	s := fmt.Sprintf("T%d game over", e.Turn)
+	if len(e.Standings) > 0 {
+		s += fmt.Sprintf(", P%d ranks first with %d worms", e.Standings[0].Player, e.Standings[0].Worms)
+	}
+
+	return s
+}
+
+// History returns every event of the current game, oldest first
+func (g *Game) History() []Event {
+	history := make([]Event, len(g.history))
+	copy(history, g.history)
+
+	return history
+}
+
+func (g *Game) record(e Event) {
+	g.history = append(g.history, e)
+}
@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGameHistory(t *testing.T) {
+	// Worm x2, Bread x2, Cucumber, Cheese - then Bread, Cucumber, Ketchup, Cheese
+	game := newScriptedGame(0, 0, 2, 2, 3, 5, 2, 3, 4, 5)
+
+	_, _ = game.Roll()
+	_ = game.Pick(Worm)
+	_, _ = game.Roll()
+	_ = game.Pick(Bread)
+	_ = game.StopTurn()
+
+	want := []Event{
+		RollEvent{Turn: 1, Player: 1, Roll: []Symbol{Worm, Worm, Bread, Bread, Cucumber, Cheese}},
+		PickEvent{Turn: 1, Player: 1, Symbol: Worm, Count: 2},
+		RollEvent{Turn: 1, Player: 1, Roll: []Symbol{Bread, Cucumber, Ketchup, Cheese}},
+		PickEvent{Turn: 1, Player: 1, Symbol: Bread, Count: 1},
+		TileTakenEvent{Turn: 1, Player: 1, Tile: Tile{Value: 4, Worms: 1}, Score: 4},
+	}
+
+	if got := game.History(); !reflect.DeepEqual(got, want) {
+		t.Errorf("History() = %v, want %v", got, want)
+	}
+
+	game.History()[0] = GameOverEvent{}
+	if _, isRoll := game.History()[0].(RollEvent); !isRoll {
+		t.Errorf("History() should return a copy")
+	}
+}
+
+func TestGameHistorySteal(t *testing.T) {
+	game := NewGame(DefaultRules, 1)
+	_ = game.Start(2, 0)
+	_, _ = game.board.Take(6)
+	_, _ = game.board.Take(6)
+	game.players[1].tiles.Push(Tile{Value: 6, Worms: 2})
+	game.dice.picked = []Symbol{Worm, Cucumber, Bread, Bread} // Score: 6
+
+	game.NextTurn()
+
+	want := TileStolenEvent{Turn: 1, Player: 1, From: 2, Tile: Tile{Value: 6, Worms: 2}}
+	if got := game.History(); len(got) != 1 || got[0] != want {
+		t.Errorf("History() = %v, want [%v]", got, want)
+	}
+}
+
+func TestGameHistoryBust(t *testing.T) {
+	game := NewGame(DefaultRules, 1)
+	_ = game.Start(2, 0)
+	for val := game.board.min; val <= game.board.max; val++ {
+		for game.board.HasTile(val) {
+			_, _ = game.board.Take(val)
+		}
+	}
+	game.board.Put(Tile{Value: 8, Worms: 3})
+	game.players[0].tiles.Push(Tile{Value: 5, Worms: 1})
+
+	game.NextTurn() // nothing picked: bust
+
+	history := game.History()
+	// The returned tile is still face up, so the game goes on
+	if len(history) != 1 {
+		t.Fatalf("History() = %v, want only a bust", history)
+	}
+
+	wantBust := BustEvent{
+		Turn:         1,
+		Player:       1,
+		ReturnedTile: Tile{Value: 5, Worms: 1},
+		Returned:     true,
+		FlippedTile:  Tile{Value: 8, Worms: 3},
+		Flipped:      true,
+	}
+	if history[0] != wantBust {
+		t.Errorf("History()[0] = %v, want %v", history[0], wantBust)
+	}
+}
+
+func TestGameHistoryGameOver(t *testing.T) {
+	game := NewGame(DefaultRules, 1)
+	_ = game.Start(2, 0)
+	for val := game.board.min; val <= game.board.max; val++ {
+		for game.board.HasTile(val) {
+			_, _ = game.board.Take(val)
+		}
+	}
+	game.board.Put(Tile{Value: 4, Worms: 1})
+	game.players[1].tiles.Push(Tile{Value: 9, Worms: 4})
+
+	game.NextTurn()
+
+	history := game.History()
+	over, isOver := history[len(history)-1].(GameOverEvent)
+	if !isOver {
+		t.Fatalf("Last event = %v, want a GameOverEvent", history[len(history)-1])
+	}
+	if len(over.Standings) != 2 || over.Standings[0].Player != 2 {
+		t.Errorf("GameOverEvent standings = %v, want P2 first", over.Standings)
+	}
+
+	game.Restart()
+	if len(game.History()) != 0 {
+		t.Errorf("History() after Restart() should be empty")
+	}
+}
@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// ExpectimaxAIStrategy plays the dice of a turn optimally. It computes the exact expected worms
+// of rolling, stopping and every pick, given the tiles that the score can take from the board
+// or steal from the opponents, and the top tile lost when busting.
+type ExpectimaxAIStrategy struct{}
+
+func NewExpectimaxAIStrategy() *ExpectimaxAIStrategy {
+	return &ExpectimaxAIStrategy{}
+}
+
+func (s *ExpectimaxAIStrategy) Name() string {
+	return "expectimax"
+}
+
+func (s *ExpectimaxAIStrategy) ShouldRoll(view GameView) (bool, Reasoning) {
+	if len(view.Picked) == 0 {
+		return decideRoll(true, "First roll of the turn")
+	}
+
+	solver := newViewSolver(view)
+	stop := solver.stopValue(view.Picked)
+	if view.DiceLeft() == 0 {
+		return decideRoll(false, fmt.Sprintf("Stopping - all dice picked, expecting %+.2f worms", stop), OptionValue{"stop", stop})
+	}
+
+	roll := solver.rollValue(view.Picked)
+	if roll > stop {
+		return decideRoll(true, fmt.Sprintf("Rolling - expecting %+.2f worms, against %+.2f by stopping", roll, stop),
+			OptionValue{"roll", roll}, OptionValue{"stop", stop})
+	}
+
+	return decideRoll(false, fmt.Sprintf("Stopping - expecting %+.2f worms, against %+.2f by rolling", stop, roll),
+		OptionValue{"roll", roll}, OptionValue{"stop", stop})
+}
+
+func (s *ExpectimaxAIStrategy) ChooseSymbol(view GameView) (Symbol, Reasoning) {
+	solver := newViewSolver(view)
+
+	best, bestValue := Symbol(-1), math.Inf(-1)
+	var options []OptionValue
+	for _, r := range view.Roll {
+		if !view.CanPick(r) || slices.ContainsFunc(options, func(o OptionValue) bool { return o.Option == r.Name() }) {
+			continue
+		}
+
+		v := solver.pickValue(view.Picked, view.Roll, r)
+		options = append(options, OptionValue{r.Name(), v})
+		if v > bestValue {
+			best, bestValue = r, v
+		}
+	}
+
+	return decidePick(best, fmt.Sprintf("Picking %s - expecting %+.2f worms", best, bestValue), options...)
+}
+
+// newViewSolver prepares a turn solver for the current player, valuing the end of the turn by its worm swing
+func newViewSolver(view GameView) *turnSolver {
+	return newTurnSolver(view.Rules, view.stopSwing, view.bustSwing())
+}
+
+// bustSwing is the worm swing of busting: the worms of the top tile of the current player are lost
+func (v GameView) bustSwing() float64 {
+	if top, exists := v.TopTile(v.Turn); exists {
+		return -float64(top.Worms)
+	}
+
+	return 0
+}
+
+// stopSwing is the worm swing of ending the turn with the score: the worms of the tile it takes,
+// twice when stolen as the opponent loses them, and the bust swing when it takes nothing
+func (v GameView) stopSwing(score int, hasWorm bool) float64 {
+	tile, from, ok := v.TileFor(score)
+	switch {
+	case !hasWorm || !ok:
+		return v.bustSwing()
+	case from >= 0:
+		return 2 * float64(tile.Worms)
+	default:
+		return float64(tile.Worms)
+	}
+}
@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTurnSolverOutcomes(t *testing.T) {
+	for _, rules := range RuleSets() {
+		t.Run(rules.Name, func(t *testing.T) {
+			solver := newTurnSolver(rules, func(int, bool) float64 { return 0 }, 0)
+
+			tests := [][]Symbol{nil, {Worm}, {Worm, Bread, Bread}}
+			for _, picked := range tests {
+				var total float64
+				for _, o := range solver.outcomes(solver.state(picked)) {
+					total += o.prob
+				}
+
+				if math.Abs(total-1) > 1e-9 {
+					t.Errorf("outcomes() after picking %v sum to %f, want 1", picked, total)
+				}
+			}
+		})
+	}
+}
+
+func TestTurnSolverBustChance(t *testing.T) {
+	solver := newTurnSolver(MiniRules, func(int, bool) float64 { return 0 }, 0)
+
+	tests := []struct {
+		name   string
+		picked []Symbol
+		want   float64
+	}{
+		{"first roll", nil, 0},
+		{"one die left, one symbol missing", []Symbol{Worm, Worm, Bread, Cucumber, Ketchup}, 5.0 / 6},
+		{"two dice left, worm picked", []Symbol{Worm, Worm, Worm, Worm}, 1.0 / 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := solver.bustChance(tt.picked); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("bustChance(%v) = %f, want %f", tt.picked, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpectimaxAIStrategy(t *testing.T) {
+	ai := NewExpectimaxAIStrategy()
+
+	game := newScriptedGame()
+	if roll, _ := ai.ShouldRoll(game.View()); !roll {
+		t.Errorf("ShouldRoll() at turn start = false, want true")
+	}
+
+	// 9 with a worm takes the highest tile, rolling the last die can only risk a bust
+	game.dice.picked = []Symbol{Worm, Bread, Bread, Bread, Bread}
+	if roll, explanation := ai.ShouldRoll(game.View()); roll {
+		t.Errorf("ShouldRoll() with 9 and a worm = true (%s), want false", explanation)
+	}
+
+	// The worm completes 9, a cheese would still need a worm from the last die
+	game.dice.picked = []Symbol{Bread, Bread, Bread, Bread}
+	game.dice.roll = []Symbol{Cheese, Worm}
+	if s, explanation := ai.ChooseSymbol(game.View()); s != Worm {
+		t.Errorf("ChooseSymbol() = %v (%s), want %v", s, explanation, Worm)
+	}
+
+	// Without a worm yet, stopping is a bust
+	game.dice.picked = []Symbol{Bread, Bread, Cheese}
+	game.dice.roll = nil
+	if roll, explanation := ai.ShouldRoll(game.View()); !roll {
+		t.Errorf("ShouldRoll() without a worm = false (%s), want true", explanation)
+	}
+}
+
+func TestExpectimaxAIStrategyClassic(t *testing.T) {
+	game := NewGame(ClassicRules, 3)
+	players := []Player{NewPlayer(AI), NewPlayer(AI)}
+	for i := range players {
+		players[i].ai = NewExpectimaxAIStrategy()
+	}
+	_ = game.StartWith(players)
+
+	for turns := 0; turns < 3 && game.State == GameLoop; turns++ {
+		ai := game.CurrentPlayer().ai
+		for {
+			if roll, _ := ai.ShouldRoll(game.View()); !roll {
+				break
+			}
+			if _, err := game.Roll(); err != nil {
+				t.Fatalf("Roll() returned error: %v", err)
+			}
+			if game.Phase() == Busted {
+				break
+			}
+
+			s, _ := ai.ChooseSymbol(game.View())
+			if err := game.Pick(s); err != nil {
+				t.Fatalf("Pick(%v) returned error: %v", s, err)
+			}
+			if game.dice.IsDone() {
+				break
+			}
+		}
+
+		if game.Phase() == AwaitingRoll {
+			if err := game.StopTurn(); err != nil {
+				t.Fatalf("StopTurn() returned error: %v", err)
+			}
+		}
+		game.NextTurn()
+	}
+}
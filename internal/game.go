@@ -3,13 +3,15 @@ package internal
 import (
 	"errors"
 	"fmt"
+	"io"
+	"slices"
 	"strings"
 )
 
 var (
 	ErrGameOver          = errors.New("the game is over")
 	ErrGameNotOver       = errors.New("the game is not over yet")
-	ErrPlayersOutOfRange = fmt.Errorf("a game must have between %d and %d players", minPlayers, maxPlayers)
+	ErrPlayersOutOfRange = errors.New("wrong number of players")
 )
 
 type GameState int
@@ -18,42 +20,79 @@ const (
 	GameMenu GameState = iota
 	GameLoop
 	GameOver
-
-	minPlayers = 2
-	maxPlayers = 4
 )
 
 type Game struct {
 	State   GameState
-	Dice    *Dice
+	dice    *Dice
+	rules   RuleSet
+	seed    int64
 	players []Player
 	turn    int
+	turnNr  int // counts the turns played since the start, from 1
+	phase   TurnPhase
 	board   *Board
+	history []Event
+	actions []Action
+	hints   []HintSnapshot // asked during the game, to rebuild their events when the game is restored
+
+	reasoning *Reasoning // explanation of the decision an AI player is about to act on
 }
 
-func NewGame() *Game {
+// NewGame creates a game with the given rules. All dice throws derive from the seed,
+// so two games with the same seed and the same decisions play out identically. What the rules leave unset
+// takes the value of the default rules.
+func NewGame(rules RuleSet, seed int64) *Game {
+	rules = rules.WithDefaults()
+
 	return &Game{
 		State: GameMenu,
-		Dice:  NewDice(DefaultDiceCount),
+		dice:  NewDice(rules, NewSeededRoller(seed)),
+		rules: rules,
+		seed:  seed,
 		turn:  0,
-		board: NewDefaultBoard(),
+		board: NewBoard(rules.Tiles),
 	}
 }
 
-func (g *Game) Start(humanPlayers, aiPlayers int) (err error) {
-	if humanPlayers+aiPlayers < minPlayers || humanPlayers+aiPlayers > maxPlayers {
-		return ErrPlayersOutOfRange
-	}
+// Dice returns the dice of the current turn, to be changed through Roll and Pick only
+func (g *Game) Dice() *Dice {
+	return g.dice
+}
 
+func (g *Game) Rules() RuleSet {
+	return g.rules
+}
+
+// Seed returns the seed the dice of the current game were created with
+func (g *Game) Seed() int64 {
+	return g.seed
+}
+
+func (g *Game) Start(humanPlayers, aiPlayers int) (err error) {
+	var players []Player
 	for i := 0; i < humanPlayers; i++ {
-		g.players = append(g.players, NewPlayer(Human))
+		players = append(players, NewPlayer(Human))
 	}
 	for i := 0; i < aiPlayers; i++ {
-		g.players = append(g.players, NewPlayer(AI))
+		players = append(players, NewPlayer(AI))
 	}
 
+	return g.StartWith(players)
+}
+
+// StartWith starts the game with the given players, in turn order
+func (g *Game) StartWith(players []Player) (err error) {
+	if len(players) < g.rules.MinPlayers || len(players) > g.rules.MaxPlayers {
+		return fmt.Errorf("%w: a game must have between %d and %d players",
+			ErrPlayersOutOfRange, g.rules.MinPlayers, g.rules.MaxPlayers)
+	}
+
+	g.players = players
 	g.State = GameLoop
 	g.turn = 0
+	g.turnNr = 1
+	g.phase = AwaitingRoll
 
 	return
 }
@@ -63,30 +102,70 @@ func (g *Game) Restart() {
 
 	g.players = nil
 	g.turn = 0
-	g.board = NewDefaultBoard()
-	g.Dice.Reset()
+	g.turnNr = 0
+	g.phase = AwaitingRoll
+	g.history = nil
+	g.hints = nil
+	g.actions = nil
+	g.reasoning = nil
+	g.board = NewBoard(g.rules.Tiles)
+
+	// Every new game gets the next seed, so that it can be reproduced on its own
+	g.seed++
+	g.dice = NewDice(g.rules, NewSeededRoller(g.seed))
 }
 
 func (g *Game) Stop() {
+	if g.State == GameLoop {
+		g.record(GameOverEvent{Turn: g.turnNr, Standings: g.Standings()})
+	}
+
+	// Strategies running outside the game, like bots, are not needed anymore
+	_ = g.Close()
+
 	g.State = GameOver
 }
 
+// Close stops the strategies of the players that run outside the game, like bots, leaving the game as it is.
+// The strategies start again if they are asked for another decision.
+func (g *Game) Close() error {
+	var errs []error
+	for _, p := range g.players {
+		if closer, isCloser := p.ai.(io.Closer); isCloser {
+			errs = append(errs, closer.Close())
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func (g *Game) CurrentTurn() (playerN int, justStarted bool, err error) {
 	if g.State != GameLoop {
 		return 0, false, ErrGameOver
 	}
 
-	return g.turn + 1, len(g.Dice.picked) == 0, nil
+	return g.turn + 1, len(g.dice.picked) == 0, nil
+}
+
+// Players returns the players of the game, in turn order
+func (g *Game) Players() []Player {
+	return slices.Clone(g.players)
 }
 
 func (g *Game) CurrentPlayer() Player {
 	return g.players[g.turn]
 }
 
+// NextTurn moves to the next player. A turn that has not ended yet is resolved with the dice picked so far.
 func (g *Game) NextTurn() {
-	g.resolveCurrentTurn()
-	g.Dice.Reset()
+	if !g.phase.IsOver() {
+		g.resolveCurrentTurn()
+	}
+	g.act(Action{Kind: NextTurnAction})
+	g.dice.reset()
+	g.phase = AwaitingRoll
 
+	// The game ends when no face up tiles are left
 	if g.board.IsEmpty() {
 		g.Stop()
 
@@ -94,6 +173,7 @@ func (g *Game) NextTurn() {
 	}
 
 	g.turn++
+	g.turnNr++
 	if len(g.players) == g.turn {
 		g.turn = 0
 	}
@@ -101,6 +181,16 @@ func (g *Game) NextTurn() {
 	return
 }
 
+// Scorecards returns the current scorecard of every player, in turn order
+func (g *Game) Scorecards() []Scorecard {
+	scorecards := make([]Scorecard, 0, len(g.players))
+	for _, p := range g.players {
+		scorecards = append(scorecards, p.Scorecard())
+	}
+
+	return scorecards
+}
+
 func (g *Game) FinalScores() ([]Player, error) {
 	if g.State != GameOver {
 		return nil, ErrGameNotOver
@@ -123,8 +213,12 @@ func (g *Game) String() string {
 }
 
 func (g *Game) resolveCurrentTurn() {
-	diceScore, noWorms := g.Dice.PickedScore()
-	if diceScore == 0 || noWorms {
+	g.phase = Resolved
+
+	diceScore, noWorms := g.dice.PickedScore()
+	if diceScore == 0 || noWorms || g.dice.IsBusted() {
+		g.bust()
+
 		return
 	}
 
@@ -133,7 +227,6 @@ func (g *Game) resolveCurrentTurn() {
 
 	if err != nil {
 		// If there is no available tile on the board, then try to rob from other players decks.
-		var robbed bool
 		for i, p := range g.players {
 			if i == g.turn { // Skip current player
 				continue
@@ -141,26 +234,52 @@ func (g *Game) resolveCurrentTurn() {
 
 			top, hasTiles := p.tiles.Top()
 			if hasTiles && top.Value == diceScore {
-				tile, robbed = p.tiles.Pop()
+				tile, _ = p.tiles.Pop()
+				g.players[g.turn].tiles.Push(tile)
+				g.record(TileStolenEvent{Turn: g.turnNr, Player: g.turn + 1, From: i + 1, Tile: tile})
 
-				break
+				return
 			}
 		}
 
 		// If there is nothing to rob then pick a lower value tile from the board, if available.
-		if !robbed {
-			for i := diceScore - 1; i >= g.board.min; i-- {
-				tile, err = g.board.Take(i)
-				if err == nil {
-					break
-				}
+		for i := diceScore - 1; i >= g.board.min; i-- {
+			tile, err = g.board.Take(i)
+			if err == nil {
+				break
 			}
 		}
 	}
 
-	if tile.Value != 0 {
-		g.players[g.turn].tiles.Push(tile)
+	if tile.Value == 0 {
+		g.bust()
+
+		return
 	}
 
+	g.players[g.turn].tiles.Push(tile)
+	g.record(TileTakenEvent{Turn: g.turnNr, Player: g.turn + 1, Tile: tile, Score: diceScore})
+
 	return
 }
+
+// bust applies the penalty for a failed turn: the current player's top tile goes back to the board,
+// then the highest face up tile on the board is turned face down, unless it is the one just returned.
+func (g *Game) bust() {
+	g.phase = Busted
+
+	returned, hadTile := g.players[g.turn].tiles.Pop()
+	if hadTile {
+		g.board.Put(returned)
+	}
+
+	e := BustEvent{Turn: g.turnNr, Player: g.turn + 1, ReturnedTile: returned, Returned: hadTile}
+
+	highest, exists := g.board.Highest()
+	if exists && (!hadTile || highest.Value != returned.Value) {
+		e.FlippedTile, _ = g.board.Flip(highest.Value)
+		e.Flipped = true
+	}
+
+	g.record(e)
+}
@@ -2,17 +2,18 @@ package internal
 
 import (
 	"errors"
+	"slices"
 	"testing"
 )
 
 func TestNewGame(t *testing.T) {
-	game := NewGame()
+	game := NewGame(DefaultRules, 1)
 
 	if game.State != GameMenu {
 		t.Errorf("New game state = %v, want %v", game.State, GameMenu)
 	}
 
-	if game.Dice == nil {
+	if game.dice == nil {
 		t.Errorf("New game should have dice")
 	}
 
@@ -21,6 +22,21 @@ func TestNewGame(t *testing.T) {
 	}
 }
 
+func TestNewGameWithPartialRules(t *testing.T) {
+	for _, rules := range []RuleSet{{}, {Name: "eight dice", DiceCount: 8}} {
+		game := NewGame(rules, 1)
+		if err := game.Start(2, 0); err != nil {
+			t.Errorf("Start() with rules %+v returned error: %v", rules, err)
+		}
+		if game.board.IsEmpty() {
+			t.Errorf("Board with rules %+v should get the default tiles", rules)
+		}
+		if got := game.Rules().DiceCount; got != max(rules.DiceCount, DefaultDiceCount) {
+			t.Errorf("Rules().DiceCount = %d, want %d", got, max(rules.DiceCount, DefaultDiceCount))
+		}
+	}
+}
+
 func TestGameStart(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -32,11 +48,12 @@ func TestGameStart(t *testing.T) {
 		{"valid mixed game", 1, 1, false},
 		{"not enough players", 1, 0, true},
 		{"zero players", 0, 0, true},
+		{"too many players", 3, 2, true},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			game := NewGame()
+			game := NewGame(DefaultRules, 1)
 			err := game.Start(tt.humanPlayers, tt.aiPlayers)
 
 			if (err != nil) != tt.wantErr {
@@ -60,8 +77,62 @@ func TestGameStart(t *testing.T) {
 	}
 }
 
+func TestGameStartWithClassicRules(t *testing.T) {
+	game := NewGame(ClassicRules, 1)
+
+	if err := game.Start(3, 4); err != nil {
+		t.Fatalf("Start(3, 4) with classic rules returned error: %v", err)
+	}
+
+	if game.dice.count != 8 {
+		t.Errorf("Classic game dice count = %d, want 8", game.dice.count)
+	}
+
+	if game.board.min != 21 || game.board.max != 36 {
+		t.Errorf("Classic board range = %d-%d, want 21-36", game.board.min, game.board.max)
+	}
+
+	err := NewGame(ClassicRules, 1).Start(8, 0)
+	if !errors.Is(err, ErrPlayersOutOfRange) {
+		t.Errorf("Start(8, 0) with classic rules error = %v, want %v", err, ErrPlayersOutOfRange)
+	}
+}
+
+func TestGameSeedReproducible(t *testing.T) {
+	play := func() string {
+		game := NewGame(DefaultRules, 7)
+		_ = game.Start(2, 0)
+
+		for turn := 0; turn < 6 && game.State == GameLoop; turn++ {
+			for game.Phase() == AwaitingRoll && !game.Dice().IsDone() {
+				roll, err := game.Roll()
+				if err != nil {
+					t.Fatalf("Roll() returned error: %v", err)
+				}
+				if game.Phase() != AwaitingPick {
+					break
+				}
+				pickable := slices.IndexFunc(roll, game.Dice().CanPick)
+				if err = game.Pick(roll[pickable]); err != nil {
+					t.Fatalf("Pick() returned error: %v", err)
+				}
+			}
+			if game.Phase() == AwaitingRoll {
+				_ = game.StopTurn()
+			}
+			game.NextTurn()
+		}
+
+		return game.String()
+	}
+
+	if first, second := play(), play(); first != second {
+		t.Errorf("Games with the same seed differ:\n%s\nvs\n%s", first, second)
+	}
+}
+
 func TestGameRestart(t *testing.T) {
-	game := NewGame()
+	game := NewGame(DefaultRules, 1)
 	_ = game.Start(2, 0)
 	game.turn = 1
 
@@ -78,10 +149,14 @@ func TestGameRestart(t *testing.T) {
 	if game.turn != 0 {
 		t.Errorf("After Restart(), turn = %d, want 0", game.turn)
 	}
+
+	if game.Seed() != 2 {
+		t.Errorf("After Restart(), seed = %d, want 2", game.Seed())
+	}
 }
 
 func TestGameCurrentTurn(t *testing.T) {
-	game := NewGame()
+	game := NewGame(DefaultRules, 1)
 
 	// Test before game starts
 	_, _, err := game.CurrentTurn()
@@ -106,7 +181,7 @@ func TestGameCurrentTurn(t *testing.T) {
 	}
 
 	// Add some picked dice and check again
-	game.Dice.picked = []Symbol{Worm}
+	game.dice.picked = []Symbol{Worm}
 	_, justStarted, _ = game.CurrentTurn()
 
 	if justStarted {
@@ -115,7 +190,7 @@ func TestGameCurrentTurn(t *testing.T) {
 }
 
 func TestGameNextTurn(t *testing.T) {
-	game := NewGame()
+	game := NewGame(DefaultRules, 1)
 	_ = game.Start(3, 0)
 
 	// First turn should be player 1 (index 0)
@@ -151,7 +226,7 @@ func TestGameResolveCurrentTurn(t *testing.T) {
 			name: "no worms - should not get tile",
 			setupGame: func(g *Game) {
 				g.Start(2, 0)
-				g.Dice.picked = []Symbol{Bread, Cucumber, Ketchup}
+				g.dice.picked = []Symbol{Bread, Cucumber, Ketchup}
 			},
 			wantTileValue: 0,
 			wantTileCount: 0,
@@ -161,7 +236,7 @@ func TestGameResolveCurrentTurn(t *testing.T) {
 			name: "with worms - should get exact tile",
 			setupGame: func(g *Game) {
 				g.Start(2, 0)
-				g.Dice.picked = []Symbol{Worm, Bread, Bread, Cucumber} // Score: 6
+				g.dice.picked = []Symbol{Worm, Bread, Bread, Cucumber} // Score: 6
 			},
 			wantTileValue: 6,
 			wantTileCount: 1,
@@ -175,7 +250,7 @@ func TestGameResolveCurrentTurn(t *testing.T) {
 				_, _ = g.board.Take(8)
 				_, _ = g.board.Take(8)
 				// Roll score of 8
-				g.Dice.picked = []Symbol{Worm, Worm, Bread, Bread, Bread} // Score: 8
+				g.dice.picked = []Symbol{Worm, Worm, Bread, Bread, Bread} // Score: 8
 			},
 			wantTileValue: 7, // Should get next available lower tile
 			wantTileCount: 1,
@@ -191,7 +266,7 @@ func TestGameResolveCurrentTurn(t *testing.T) {
 				// Give opponent a tile
 				g.players[1].tiles.Push(Tile{Value: 6, Worms: 2})
 				// Roll matching score
-				g.Dice.picked = []Symbol{Worm, Cucumber, Bread, Bread} // Score: 6
+				g.dice.picked = []Symbol{Worm, Cucumber, Bread, Bread} // Score: 6
 			},
 			wantTileValue: 6,
 			wantTileCount: 1,
@@ -204,7 +279,7 @@ func TestGameResolveCurrentTurn(t *testing.T) {
 				// Give opponent a tile
 				g.players[1].tiles.Push(Tile{Value: 5, Worms: 1})
 				// Roll matching score, but tile also available on board
-				g.Dice.picked = []Symbol{Worm, Bread, Bread} // Score: 5
+				g.dice.picked = []Symbol{Worm, Bread, Bread} // Score: 5
 			},
 			wantTileValue: 5,
 			wantTileCount: 1,
@@ -220,7 +295,7 @@ func TestGameResolveCurrentTurn(t *testing.T) {
 						_, _ = g.board.Take(val)
 					}
 				}
-				g.Dice.picked = []Symbol{Worm, Bread, Bread} // Score: 5
+				g.dice.picked = []Symbol{Worm, Bread, Bread} // Score: 5
 			},
 			wantTileValue: 0,
 			wantTileCount: 0,
@@ -230,7 +305,7 @@ func TestGameResolveCurrentTurn(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			game := NewGame()
+			game := NewGame(DefaultRules, 1)
 			tt.setupGame(game)
 
 			initialPlayerTiles := game.players[game.turn].tiles.Len()
@@ -261,3 +336,142 @@ func TestGameResolveCurrentTurn(t *testing.T) {
 		})
 	}
 }
+
+func TestGameBustPenalty(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupGame      func(*Game)
+		wantPlayerTop  int // 0 means no tiles left
+		wantBoardCount map[int]int
+		wantFaceDown   int
+	}{
+		{
+			name: "no worms - top tile returned and highest flipped",
+			setupGame: func(g *Game) {
+				g.Start(2, 0)
+				_, _ = g.board.Take(6)
+				g.players[0].tiles.Push(Tile{Value: 4, Worms: 1})
+				g.players[0].tiles.Push(Tile{Value: 6, Worms: 2})
+				g.dice.picked = []Symbol{Bread, Cucumber, Ketchup}
+			},
+			wantPlayerTop:  4,
+			wantBoardCount: map[int]int{6: 2, 9: 1},
+			wantFaceDown:   1,
+		},
+		{
+			name: "no tiles to lose - highest still flipped",
+			setupGame: func(g *Game) {
+				g.Start(2, 0)
+				g.dice.picked = []Symbol{Bread, Cheese}
+			},
+			wantPlayerTop:  0,
+			wantBoardCount: map[int]int{9: 1},
+			wantFaceDown:   1,
+		},
+		{
+			name: "returned tile is the highest - nothing flipped",
+			setupGame: func(g *Game) {
+				g.Start(2, 0)
+				_, _ = g.board.Take(9)
+				_, _ = g.board.Take(9)
+				g.players[0].tiles.Push(Tile{Value: 9, Worms: 4})
+				g.dice.picked = []Symbol{Cheese}
+			},
+			wantPlayerTop:  0,
+			wantBoardCount: map[int]int{9: 1, 8: 2},
+			wantFaceDown:   0,
+		},
+		{
+			name: "unpickable roll busts even with worms picked",
+			setupGame: func(g *Game) {
+				g.Start(2, 0)
+				g.players[0].tiles.Push(Tile{Value: 5, Worms: 1})
+				g.dice.picked = []Symbol{Worm, Worm, Bread}
+				g.dice.roll = []Symbol{Worm, Bread, Bread}
+			},
+			wantPlayerTop:  0,
+			wantBoardCount: map[int]int{5: 3, 9: 1},
+			wantFaceDown:   1,
+		},
+		{
+			name: "score with no tile anywhere busts",
+			setupGame: func(g *Game) {
+				g.Start(2, 0)
+				_, _ = g.board.Take(4)
+				_, _ = g.board.Take(4)
+				g.players[0].tiles.Push(Tile{Value: 4, Worms: 1})
+				g.dice.picked = []Symbol{Worm, Cheese, Cheese} // Score: 3
+			},
+			wantPlayerTop:  0,
+			wantBoardCount: map[int]int{4: 1, 9: 1},
+			wantFaceDown:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := NewGame(DefaultRules, 1)
+			tt.setupGame(game)
+
+			game.resolveCurrentTurn()
+
+			top, exists := game.players[0].tiles.Top()
+			if tt.wantPlayerTop == 0 && exists {
+				t.Errorf("Player should have no tiles left, got top %d", top.Value)
+			}
+			if tt.wantPlayerTop != 0 && (!exists || top.Value != tt.wantPlayerTop) {
+				t.Errorf("Player top tile = %d, want %d", top.Value, tt.wantPlayerTop)
+			}
+
+			for val, count := range tt.wantBoardCount {
+				if got := len(game.board.tiles[val]); got != count {
+					t.Errorf("Board has %d tiles of value %d, want %d", got, val, count)
+				}
+			}
+
+			if got := game.board.FaceDownCount(); got != tt.wantFaceDown {
+				t.Errorf("Board has %d face down tiles, want %d", got, tt.wantFaceDown)
+			}
+		})
+	}
+}
+
+func TestGameEndsWhenOnlyFaceDownTilesRemain(t *testing.T) {
+	game := NewGame(DefaultRules, 1)
+	_ = game.Start(2, 0)
+
+	for val := game.board.min; val <= game.board.max; val++ {
+		for game.board.HasTile(val) {
+			_, _ = game.board.Take(val)
+		}
+	}
+	game.board.Put(Tile{Value: 4, Worms: 1})
+
+	// A bust with no tiles to return flips the last face up tile
+	game.NextTurn()
+
+	if game.State != GameOver {
+		t.Errorf("Game state = %v, want %v when only face down tiles remain", game.State, GameOver)
+	}
+}
+
+func TestGameFinalScoresRepeatable(t *testing.T) {
+	game := NewGame(DefaultRules, 1)
+	_ = game.Start(2, 0)
+	game.players[0].tiles.Push(Tile{Value: 7, Worms: 2})
+	game.Stop()
+
+	for i := 0; i < 2; i++ {
+		players, err := game.FinalScores()
+		if err != nil {
+			t.Fatalf("FinalScores() returned error: %v", err)
+		}
+		if worms, _ := players[0].Score(); worms != 2 {
+			t.Errorf("FinalScores() call #%d: P1 worms = %d, want 2", i+1, worms)
+		}
+	}
+
+	if sc := game.Scorecards(); len(sc) != 2 || sc[0].Worms != 2 || sc[1].HasTile {
+		t.Errorf("Scorecards() = %+v, want P1 with 2 worms and P2 without tiles", sc)
+	}
+}
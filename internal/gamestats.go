@@ -0,0 +1,176 @@
+package internal
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var ErrStatsFormat = errors.New("unknown stats file format, use .csv or .jsonl")
+
+// GameStats sums up a finished game
+type GameStats struct {
+	Seed    int64         `json:"seed"`
+	Rules   string        `json:"rules"`
+	Turns   int           `json:"turns"`  // length of the game, in turns of all the players
+	Winner  int           `json:"winner"` // player number, 0 when the first players tied
+	Margin  int           `json:"margin"` // worms of the winner ahead of the runner-up
+	Steals  int           `json:"steals"`
+	Players []PlayerStats `json:"players"` // in turn order
+}
+
+type PlayerStats struct {
+	Player           int     `json:"player"`
+	Strategy         string  `json:"strategy"` // "human" for human players
+	Rank             int     `json:"rank"`
+	Worms            int     `json:"worms"`
+	Turns            int     `json:"turns"`
+	Busts            int     `json:"busts"`
+	Steals           int     `json:"steals"`
+	TilesLost        int     `json:"tilesLost"`        // put back on the board when busting, or stolen
+	AverageTurnScore float64 `json:"averageTurnScore"` // score of the tiles taken or stolen, busted turns scoring 0
+	Hints            int     `json:"hints"`
+}
+
+// Stats sums up the finished game from its events
+func (g *Game) Stats() (GameStats, error) {
+	if g.State != GameOver {
+		return GameStats{}, ErrGameNotOver
+	}
+
+	s := GameStats{Seed: g.seed, Rules: g.rules.Name}
+	scores := make([]int, len(g.players))
+	for i, p := range g.players {
+		ps := PlayerStats{Player: i + 1, Strategy: "human", Hints: p.hints}
+		if p.IsAI() && p.ai != nil {
+			ps.Strategy = p.ai.Name()
+		}
+		s.Players = append(s.Players, ps)
+	}
+
+	for _, e := range g.history {
+		switch e := e.(type) {
+		case TileTakenEvent:
+			s.Players[e.Player-1].Turns++
+			scores[e.Player-1] += e.Score
+		case TileStolenEvent:
+			s.Players[e.Player-1].Turns++
+			s.Players[e.Player-1].Steals++
+			s.Players[e.From-1].TilesLost++
+			scores[e.Player-1] += e.Tile.Value
+			s.Steals++
+		case BustEvent:
+			s.Players[e.Player-1].Turns++
+			s.Players[e.Player-1].Busts++
+			if e.Returned {
+				s.Players[e.Player-1].TilesLost++
+			}
+		}
+	}
+
+	for i := range s.Players {
+		ps := &s.Players[i]
+		s.Turns += ps.Turns
+		if ps.Turns > 0 {
+			ps.AverageTurnScore = float64(scores[i]) / float64(ps.Turns)
+		}
+	}
+
+	standings := g.Standings()
+	for _, st := range standings {
+		s.Players[st.Player-1].Rank, s.Players[st.Player-1].Worms = st.Rank, st.Worms
+	}
+	if len(standings) > 0 && !standings[0].Tied {
+		s.Winner = standings[0].Player
+		if len(standings) > 1 {
+			s.Margin = standings[0].Worms - standings[1].Worms
+		}
+	}
+
+	return s, nil
+}
+
+// RecordStats sums up a finished game from its record
+func RecordStats(r Record) (GameStats, error) {
+	g, err := Replay(r, nil)
+	if err != nil {
+		return GameStats{}, err
+	}
+
+	return g.Stats()
+}
+
+// StatsWriter appends game stats to a file. CSV files get one row per player of every game, so that every
+// column holds a single value. JSON Lines files get one object per game.
+type StatsWriter struct {
+	file *os.File
+	csv  *csv.Writer
+	json *json.Encoder
+}
+
+var statsColumns = []string{
+	"seed", "rules", "turns", "winner", "margin", "steals",
+	"player", "strategy", "rank", "worms", "playerTurns", "busts", "playerSteals", "tilesLost", "averageTurnScore", "hints",
+}
+
+// OpenStatsWriter opens the file to append stats to, with the format of its extension: .csv or .jsonl
+func OpenStatsWriter(path string) (*StatsWriter, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+	if ext != ".csv" && ext != ".jsonl" {
+		return nil, fmt.Errorf("%s: %w", path, ErrStatsFormat)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &StatsWriter{file: f}
+	if ext == ".jsonl" {
+		w.json = json.NewEncoder(f)
+
+		return w, nil
+	}
+
+	w.csv = csv.NewWriter(f)
+	if info, err := f.Stat(); err == nil && info.Size() == 0 {
+		_ = w.csv.Write(statsColumns)
+	}
+
+	return w, nil
+}
+
+func (w *StatsWriter) Write(s GameStats) error {
+	if w.json != nil {
+		return w.json.Encode(s)
+	}
+
+	for _, p := range s.Players {
+		row := []string{
+			strconv.FormatInt(s.Seed, 10), s.Rules, strconv.Itoa(s.Turns), strconv.Itoa(s.Winner),
+			strconv.Itoa(s.Margin), strconv.Itoa(s.Steals),
+			strconv.Itoa(p.Player), p.Strategy, strconv.Itoa(p.Rank), strconv.Itoa(p.Worms), strconv.Itoa(p.Turns),
+			strconv.Itoa(p.Busts), strconv.Itoa(p.Steals), strconv.Itoa(p.TilesLost),
+			strconv.FormatFloat(p.AverageTurnScore, 'f', 3, 64), strconv.Itoa(p.Hints),
+		}
+		if err := w.csv.Write(row); err != nil {
+			return err
+		}
+	}
+	w.csv.Flush()
+
+	return w.csv.Error()
+}
+
+func (w *StatsWriter) Close() error {
+	if w.csv != nil {
+		w.csv.Flush()
+	}
+
+	return w.file.Close()
+}
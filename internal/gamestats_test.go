@@ -0,0 +1,111 @@
+package internal
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGameStats(t *testing.T) {
+	game := NewGame(DefaultRules, 6)
+	if _, err := game.Stats(); !errors.Is(err, ErrGameNotOver) {
+		t.Errorf("Stats() before the end error = %v, want %v", err, ErrGameNotOver)
+	}
+
+	players, _ := NewPlayers(0, "easy", "simple", "expectimax")
+	_ = game.StartWith(players)
+	if err := game.AutoPlay(); err != nil {
+		t.Fatalf("AutoPlay() returned error: %v", err)
+	}
+
+	stats, err := game.Stats()
+	if err != nil {
+		t.Fatalf("Stats() returned error: %v", err)
+	}
+
+	turns, steals, busts, lost, returned := 0, 0, 0, 0, 0
+	for _, a := range game.Actions() {
+		if a.Kind == NextTurnAction {
+			turns++
+		}
+	}
+	for _, e := range game.History() {
+		if bust, isBust := e.(BustEvent); isBust && bust.Returned {
+			returned++
+		}
+	}
+	for i, p := range stats.Players {
+		if p.Player != i+1 || p.Strategy != players[i].StrategyName() {
+			t.Errorf("Players[%d] = %+v, want P%d playing %s", i, p, i+1, players[i].StrategyName())
+		}
+		steals, busts, lost = steals+p.Steals, busts+p.Busts, lost+p.TilesLost
+	}
+	if stats.Turns != turns || stats.Steals != steals || lost != steals+returned {
+		t.Errorf("Stats() = %d turns, %d steals, %d tiles lost, want %d turns, %d steals, %d tiles lost",
+			stats.Turns, stats.Steals, lost, turns, steals, steals+returned)
+	}
+
+	standings := game.Standings()
+	if stats.Winner != standings[0].Player || stats.Margin != standings[0].Worms-standings[1].Worms {
+		t.Errorf("Stats() winner P%d by %d, want P%d by %d", stats.Winner, stats.Margin,
+			standings[0].Player, standings[0].Worms-standings[1].Worms)
+	}
+
+	replayed, err := RecordStats(game.Record())
+	if err != nil || !reflect.DeepEqual(replayed, stats) {
+		t.Errorf("RecordStats() = %+v, %v, want %+v", replayed, err, stats)
+	}
+}
+
+func TestStatsWriter(t *testing.T) {
+	dir := t.TempDir()
+	stats := GameStats{Seed: 3, Rules: "mini", Turns: 20, Winner: 2, Margin: 1, Players: []PlayerStats{
+		{Player: 1, Strategy: "human", Rank: 2, Worms: 4, Turns: 10, Hints: 2},
+		{Player: 2, Strategy: "simple", Rank: 1, Worms: 5, Turns: 10, AverageTurnScore: 4.5},
+	}}
+
+	csvPath, jsonPath := filepath.Join(dir, "stats.csv"), filepath.Join(dir, "stats.jsonl")
+	for _, path := range []string{csvPath, jsonPath} {
+		// Opening again appends to the file
+		for i := 0; i < 2; i++ {
+			w, err := OpenStatsWriter(path)
+			if err != nil {
+				t.Fatalf("OpenStatsWriter(%s) returned error: %v", path, err)
+			}
+			if err = w.Write(stats); err != nil {
+				t.Errorf("Write() returned error: %v", err)
+			}
+			_ = w.Close()
+		}
+	}
+
+	data, _ := os.ReadFile(csvPath)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 5 || !strings.HasPrefix(lines[0], "seed,") || lines[1] != "3,mini,20,2,1,0,1,human,2,4,10,0,0,0,0.000,2" {
+		t.Errorf("CSV stats = %q, want a header and a row per player of both games", lines)
+	}
+
+	f, _ := os.Open(jsonPath)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	var games int
+	for scanner.Scan() {
+		var got GameStats
+		if err := json.Unmarshal(scanner.Bytes(), &got); err != nil || !reflect.DeepEqual(got, stats) {
+			t.Errorf("JSON stats line = %+v, %v, want %+v", got, err, stats)
+		}
+		games++
+	}
+	if games != 2 {
+		t.Errorf("JSON stats has %d lines, want 2", games)
+	}
+
+	if _, err := OpenStatsWriter(filepath.Join(dir, "stats.txt")); !errors.Is(err, ErrStatsFormat) {
+		t.Errorf("OpenStatsWriter(.txt) error = %v, want %v", err, ErrStatsFormat)
+	}
+}
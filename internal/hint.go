@@ -0,0 +1,40 @@
+package internal
+
+// Hint asks the strategy what it would do in the position of the current player: roll or stop while awaiting
+// a roll, which symbol to pick while awaiting a pick. The hint is counted for the player, and kept in the record.
+func (g *Game) Hint(ai AIStrategy) (Reasoning, error) {
+	if g.State != GameLoop {
+		return Reasoning{}, ErrGameOver
+	}
+
+	view := g.View()
+	var reasoning Reasoning
+	switch g.phase {
+	case AwaitingRoll:
+		_, reasoning = ai.ShouldRoll(view)
+	case AwaitingPick:
+		_, reasoning = ai.ChooseSymbol(view)
+	default:
+		return Reasoning{}, &PhaseError{Action: "hint", Phase: g.phase}
+	}
+
+	h := HintSnapshot{
+		Turn: g.turnNr, Player: g.turn + 1, Strategy: ai.Name(), Advice: reasoning.Action, Symbol: reasoning.Symbol,
+		After: len(g.actions),
+	}
+	g.players[g.turn].hints++
+	g.hints = append(g.hints, h)
+	g.record(h.event())
+
+	return reasoning.Quantify(view), nil
+}
+
+// replayHints records the events of the hints that were asked once the given number of actions were taken
+func (g *Game) replayHints(hints []HintSnapshot, after int) {
+	for _, h := range hints {
+		if h.After == after {
+			g.hints = append(g.hints, h)
+			g.record(h.event())
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package internal
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MonteCarloAIStrategy judges every option by playing the game on from it many times with random dice,
+// up to the end of the next round, and takes the one with the best average worm difference against the
+// strongest opponent. The later decisions of the simulations are taken by the simple strategy.
+type MonteCarloAIStrategy struct {
+	iterations int           // simulations per option, 0 for no limit
+	budget     time.Duration // time allowed per decision, 0 for no limit
+	seed       int64
+	rollout    AIStrategy
+}
+
+// NewMonteCarloAIStrategy creates a strategy that runs the given number of simulations per option, and
+// stops earlier once the time budget is spent. Limited by iterations only, it always takes the same
+// decisions for the same seed and game.
+func NewMonteCarloAIStrategy(iterations int, budget time.Duration, seed int64) *MonteCarloAIStrategy {
+	if iterations <= 0 && budget <= 0 {
+		iterations = 200
+	}
+
+	return &MonteCarloAIStrategy{
+		iterations: iterations,
+		budget:     budget,
+		seed:       seed,
+		rollout:    NewSimpleAIStrategy(),
+	}
+}
+
+// newMonteCarloFromArg creates the strategy from a number of simulations, as in "montecarlo:500",
+// from a time budget, as in "montecarlo:250ms", or from both, as in "montecarlo:500,250ms", optionally
+// followed by the seed of the simulations, as in "montecarlo:500@42"
+func newMonteCarloFromArg(arg string) (AIStrategy, error) {
+	arg, seedArg, hasSeed := strings.Cut(arg, "@")
+	var seed int64
+	if hasSeed {
+		var err error
+		if seed, err = strconv.ParseInt(seedArg, 10, 64); err != nil {
+			return nil, fmt.Errorf("%q is not a seed", seedArg)
+		}
+	}
+
+	if arg == "" {
+		return NewMonteCarloAIStrategy(200, 0, seed), nil
+	}
+
+	var iterations int
+	var budget time.Duration
+	for _, limit := range strings.Split(arg, ",") {
+		if n, err := strconv.Atoi(limit); err == nil && n > 0 && iterations == 0 {
+			iterations = n
+
+			continue
+		}
+		if d, err := time.ParseDuration(limit); err == nil && d > 0 && budget == 0 {
+			budget = d
+
+			continue
+		}
+
+		return nil, fmt.Errorf("%q is neither a number of simulations nor a time budget, or sets one twice", limit)
+	}
+
+	return NewMonteCarloAIStrategy(iterations, budget, seed), nil
+}
+
+// Name tells the limits and the seed of the strategy, so that NewAIStrategy creates the same strategy again
+func (s *MonteCarloAIStrategy) Name() string {
+	var limits []string
+	if s.iterations > 0 && (s.budget > 0 || s.iterations != 200 || s.seed != 0) {
+		limits = append(limits, strconv.Itoa(s.iterations))
+	}
+	if s.budget > 0 {
+		limits = append(limits, s.budget.String())
+	}
+
+	arg := strings.Join(limits, ",")
+	if s.seed != 0 {
+		arg += "@" + strconv.FormatInt(s.seed, 10)
+	}
+	if arg == "" {
+		return "montecarlo"
+	}
+
+	return "montecarlo:" + arg
+}
+
+func (s *MonteCarloAIStrategy) ShouldRoll(view GameView) (bool, Reasoning) {
+	if len(view.Picked) == 0 {
+		return decideRoll(true, "First roll of the turn")
+	}
+	if view.DiceLeft() == 0 {
+		return decideRoll(false, "Stopping - all dice picked")
+	}
+
+	values, n := s.evaluate(view, []func(*Game) error{
+		func(g *Game) error { _, err := g.Roll(); return err },
+		func(g *Game) error { return g.StopTurn() },
+	})
+
+	options := []OptionValue{{"roll", values[0]}, {"stop", values[1]}}
+	if values[0] > values[1] {
+		return decideRoll(true, fmt.Sprintf("Rolling - worm difference %+.2f, against %+.2f by stopping (%d simulations)",
+			values[0], values[1], n), options...)
+	}
+
+	return decideRoll(false, fmt.Sprintf("Stopping - worm difference %+.2f, against %+.2f by rolling (%d simulations)",
+		values[1], values[0], n), options...)
+}
+
+func (s *MonteCarloAIStrategy) ChooseSymbol(view GameView) (Symbol, Reasoning) {
+	var symbols []Symbol
+	var picks []func(*Game) error
+	for _, r := range view.Roll {
+		if view.CanPick(r) && !slices.Contains(symbols, r) {
+			symbols = append(symbols, r)
+			picks = append(picks, func(g *Game) error { return g.Pick(r) })
+		}
+	}
+	if len(symbols) == 0 {
+		return decidePick(Symbol(-1), "Nothing to pick")
+	}
+
+	values, n := s.evaluate(view, picks)
+	best := 0
+	options := make([]OptionValue, len(values))
+	for i, v := range values {
+		options[i] = OptionValue{symbols[i].Name(), v}
+		if v > values[best] {
+			best = i
+		}
+	}
+
+	return decidePick(symbols[best], fmt.Sprintf("Picking %s - worm difference %+.2f (%d simulations)",
+		symbols[best], values[best], n), options...)
+}
+
+// evaluate simulates every option in turn until the iterations or the time budget run out, and returns the
+// average worm difference of each option with the number of simulations per option. The same dice are
+// thrown for every option within an iteration, so that they are compared on equal terms.
+func (s *MonteCarloAIStrategy) evaluate(view GameView, options []func(*Game) error) (values []float64, n int) {
+	values = make([]float64, len(options))
+	deadline := time.Now().Add(s.budget)
+	base := s.seed + (int64(view.TurnNr)*64+int64(len(view.Picked)))*1_000_003
+
+	for ; s.iterations <= 0 || n < s.iterations; n++ {
+		if s.budget > 0 && n > 0 && time.Now().After(deadline) {
+			break
+		}
+
+		for i, option := range options {
+			sim := view.Simulate(NewSeededRoller(base + int64(n)))
+			if err := option(sim); err != nil {
+				values[i] += math.Inf(-1)
+
+				continue
+			}
+			values[i] += s.playOut(sim, view.Turn)
+		}
+	}
+
+	for i := range values {
+		values[i] /= float64(n)
+	}
+
+	return
+}
+
+// playOut plays the simulated game to the end of the next round, and returns the worm difference of the player
+func (s *MonteCarloAIStrategy) playOut(sim *Game, player int) float64 {
+	for turns := 0; turns <= len(sim.players) && sim.State == GameLoop; turns++ {
+		if err := playAITurn(sim, s.rollout); err != nil {
+			break
+		}
+	}
+
+	worms, best := 0, math.MinInt
+	for i, p := range sim.players {
+		if i == player {
+			worms = p.Scorecard().Worms
+		} else {
+			best = max(best, p.Scorecard().Worms)
+		}
+	}
+
+	return float64(worms - best)
+}
@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMonteCarloAIStrategyDeterministic(t *testing.T) {
+	game := newScriptedGame(0, 2, 2, 3, 4, 5)
+	_, _ = game.Roll()
+
+	first, _ := NewMonteCarloAIStrategy(50, 0, 42).ChooseSymbol(game.View())
+	for i := 0; i < 3; i++ {
+		if got, explanation := NewMonteCarloAIStrategy(50, 0, 42).ChooseSymbol(game.View()); got != first {
+			t.Fatalf("ChooseSymbol() = %v (%s), want %v with the same seed", got, explanation, first)
+		}
+	}
+
+	if !game.dice.CanPick(first) {
+		t.Errorf("ChooseSymbol() = %v, not pickable from %v", first, game.dice.roll)
+	}
+	if len(game.History()) != 1 || len(game.Actions()) != 1 {
+		t.Errorf("ChooseSymbol() changed the game: %d events, %d actions", len(game.History()), len(game.Actions()))
+	}
+}
+
+func TestMonteCarloAIStrategyShouldRoll(t *testing.T) {
+	ai := NewMonteCarloAIStrategy(100, 0, 1)
+	game := newScriptedGame()
+
+	if roll, _ := ai.ShouldRoll(game.View()); !roll {
+		t.Errorf("ShouldRoll() at turn start = false, want true")
+	}
+
+	// 9 with a worm takes the highest tile, rolling the last die can only risk a bust
+	game.dice.picked = []Symbol{Worm, Bread, Bread, Bread, Bread}
+	if roll, explanation := ai.ShouldRoll(game.View()); roll {
+		t.Errorf("ShouldRoll() with 9 and a worm = true (%s), want false", explanation)
+	}
+
+	// Without a worm yet, stopping is a bust
+	game.dice.picked = []Symbol{Bread, Bread, Cheese}
+	if roll, explanation := ai.ShouldRoll(game.View()); !roll {
+		t.Errorf("ShouldRoll() without a worm = false (%s), want true", explanation)
+	}
+}
+
+func TestMonteCarloAIStrategyBudget(t *testing.T) {
+	game := NewGame(ClassicRules, 5)
+	_ = game.Start(0, 3)
+	_, _ = game.Roll()
+
+	start := time.Now()
+	_, _ = NewMonteCarloAIStrategy(0, 20*time.Millisecond, 1).ChooseSymbol(game.View())
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("ChooseSymbol() with a 20ms budget took %v", elapsed)
+	}
+}
+
+func TestMonteCarloNameRebuildsTheStrategy(t *testing.T) {
+	for _, s := range []*MonteCarloAIStrategy{
+		NewMonteCarloAIStrategy(0, 0, 0),
+		NewMonteCarloAIStrategy(500, 250*time.Millisecond, 0),
+		NewMonteCarloAIStrategy(0, time.Second, 9),
+		NewMonteCarloAIStrategy(200, 0, 42),
+	} {
+		ai, err := NewAIStrategy(s.Name())
+		if err != nil {
+			t.Fatalf("NewAIStrategy(%q) returned error: %v", s.Name(), err)
+		}
+		if got := ai.(*MonteCarloAIStrategy); got.iterations != s.iterations || got.budget != s.budget || got.seed != s.seed {
+			t.Errorf("NewAIStrategy(%q) = %+v, want %+v", s.Name(), got, s)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrInvalidOdds = errors.New("invalid odds question")
+
+// OddsQuestion describes the rest of a turn to compute the odds of: the dice picked so far, the dice left to roll,
+// how the player picks and when the player stops
+type OddsQuestion struct {
+	Rules    RuleSet
+	Picked   []Symbol
+	DiceLeft int    // at most the dice not picked, negative for all of them, none only once dice are picked
+	Policy   string // how a symbol is picked from every roll, one of OddsPolicies
+	StopAt   int    // the player stops once the score reaches it with a worm, 0 to roll until no die is left
+	Targets  []int  // scores to compute the chance of reaching, stopping once reached unless StopAt is set
+}
+
+// Odds is the exact distribution of the end of the turn. The scores are the final PickedScore values,
+// 0 when no worm was picked, and exclude the busts of a roll showing nothing that can be picked.
+type Odds struct {
+	Scores     []ScoreChance // by increasing score
+	BustChance float64
+	StopAt     int           // score the turn of the distribution stops at, 0 when all the dice are rolled out
+	Targets    []ScoreChance // chance of ending with at least the target score and a worm
+}
+
+type ScoreChance struct {
+	Score  int
+	Chance float64
+}
+
+// oddsPolicies choose the index of the solver symbol to pick from a roll outcome
+var oddsPolicies = map[string]func(t *turnSolver, st solverState, o rollOutcome) int{
+	"most-points": pickMostPoints,
+	"worm-first": func(t *turnSolver, st solverState, o rollOutcome) int {
+		if t.worm >= 0 && !t.hasWorm(st) && o.counts[t.worm] > 0 {
+			return t.worm
+		}
+
+		return pickMostPoints(t, st, o)
+	},
+	"target": pickTowardsGoal,
+}
+
+// OddsPolicies lists the names of the pick policies: the most points of the roll, worms as soon as they show
+// and then the most points, or the best chance of reaching the score to stop at, else the lowest target
+func OddsPolicies() []string {
+	return []string{"most-points", "worm-first", "target"}
+}
+
+// ComputeOdds computes the exact odds of the rest of the turn, rolling the dice with the faces of the rules
+func ComputeOdds(q OddsQuestion) (odds Odds, err error) {
+	policy, known := oddsPolicies[q.Policy]
+	if !known {
+		return odds, fmt.Errorf("%w: unknown pick policy %q", ErrInvalidOdds, q.Policy)
+	}
+
+	solver := newTurnSolver(q.Rules, nil, 0)
+	for _, p := range q.Picked {
+		if !slices.Contains(solver.symbols, p) {
+			return odds, fmt.Errorf("%w: %s is not on the dice", ErrInvalidOdds, p)
+		}
+	}
+	start := solver.state(q.Picked)
+	switch {
+	case start.dice < 0:
+		return odds, fmt.Errorf("%w: %d dice picked out of %d", ErrInvalidOdds, len(q.Picked), solver.diceCount)
+	case q.DiceLeft == 0 && len(q.Picked) == 0:
+		return odds, fmt.Errorf("%w: no dice left for the first roll of the turn", ErrInvalidOdds)
+	case q.DiceLeft > start.dice:
+		return odds, fmt.Errorf("%w: %d dice left out of %d not picked", ErrInvalidOdds, q.DiceLeft, start.dice)
+	case q.DiceLeft >= 0:
+		start.dice = q.DiceLeft
+	}
+
+	goal := q.StopAt
+	if goal == 0 && len(q.Targets) > 0 {
+		goal = slices.Min(q.Targets)
+	}
+	if q.Policy == "target" && goal <= 0 {
+		return odds, fmt.Errorf("%w: the target policy needs a score to stop at or a target", ErrInvalidOdds)
+	}
+
+	// The target policy picks to stop at its goal, so the turn stops there
+	odds.StopAt = q.StopAt
+	if q.Policy == "target" {
+		odds.StopAt = goal
+	}
+
+	o := oddsWalk{solver: solver, policy: policy}
+	mustRoll := len(q.Picked) == 0
+	dist := o.distribution(start, mustRoll, odds.StopAt, goal)
+	for score, chance := range dist {
+		if score == oddsBust {
+			odds.BustChance = chance
+		} else {
+			odds.Scores = append(odds.Scores, ScoreChance{score, chance})
+		}
+	}
+	slices.SortFunc(odds.Scores, func(a, b ScoreChance) int { return a.Score - b.Score })
+
+	for _, target := range q.Targets {
+		reached := dist
+		if q.StopAt == 0 {
+			reached = o.distribution(start, mustRoll, target, target)
+		}
+
+		tc := ScoreChance{Score: target}
+		for score, chance := range reached {
+			if score > 0 && score >= target {
+				tc.Chance += chance
+			}
+		}
+		odds.Targets = append(odds.Targets, tc)
+	}
+
+	return odds, nil
+}
+
+// oddsBust is the key of the busts in a score distribution
+const oddsBust = -1
+
+type oddsWalk struct {
+	solver *turnSolver
+	policy func(t *turnSolver, st solverState, o rollOutcome) int
+	stopAt int
+	memo   map[solverState]map[int]float64
+}
+
+// distribution returns the distribution of the final scores from the start state, stopping once the score
+// reaches stopAt with a worm, never when 0. The target policy picks towards the goal.
+func (w *oddsWalk) distribution(start solverState, mustRoll bool, stopAt, goal int) map[int]float64 {
+	w.stopAt = stopAt
+	w.memo = map[solverState]map[int]float64{}
+	w.solver.memo = map[solverState]float64{}
+	w.solver.stop = func(score int, hasWorm bool) float64 {
+		if hasWorm && score >= goal {
+			return 1
+		}
+
+		return 0
+	}
+
+	return w.walk(start, mustRoll)
+}
+
+// walk returns the distribution of the final scores from the state. The first roll of a turn is never skipped.
+func (w *oddsWalk) walk(st solverState, mustRoll bool) map[int]float64 {
+	t := w.solver
+	if !mustRoll && (st.dice == 0 || w.stopAt > 0 && t.hasWorm(st) && st.score >= w.stopAt) {
+		if !t.hasWorm(st) {
+			return map[int]float64{0: 1}
+		}
+
+		return map[int]float64{st.score: 1}
+	}
+	if dist, known := w.memo[st]; known {
+		return dist
+	}
+
+	dist := map[int]float64{}
+	for _, o := range t.outcomes(st) {
+		if t.pickable(o) == 0 {
+			dist[oddsBust] += o.prob
+
+			continue
+		}
+
+		i := w.policy(t, st, o)
+		next := solverState{mask: st.mask | 1<<i, score: st.score + o.counts[i]*t.points[i], dice: st.dice - o.counts[i]}
+		for score, chance := range w.walk(next, false) {
+			dist[score] += o.prob * chance
+		}
+	}
+	w.memo[st] = dist
+
+	return dist
+}
+
+// pickMostPoints picks the symbol adding the most points, worms first on ties
+func pickMostPoints(t *turnSolver, _ solverState, o rollOutcome) int {
+	best := -1
+	for i, c := range o.counts {
+		if c == 0 || i >= len(t.symbols) {
+			continue
+		}
+
+		switch {
+		case best < 0, c*t.points[i] > o.counts[best]*t.points[best]:
+			best = i
+		case c*t.points[i] == o.counts[best]*t.points[best] && i == t.worm:
+			best = i
+		}
+	}
+
+	return best
+}
+
+// pickTowardsGoal picks the symbol giving the best chance to reach the goal score with a worm
+func pickTowardsGoal(t *turnSolver, st solverState, o rollOutcome) int {
+	best, bestChance := -1, -1.0
+	for i, c := range o.counts {
+		if c == 0 || i >= len(t.symbols) {
+			continue
+		}
+
+		if chance := t.value(solverState{mask: st.mask | 1<<i, score: st.score + c*t.points[i], dice: st.dice - c}); chance > bestChance {
+			best, bestChance = i, chance
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestComputeOdds(t *testing.T) {
+	tests := []struct {
+		name  string
+		q     OddsQuestion
+		want  []ScoreChance
+		bust  float64
+		goals []ScoreChance
+	}{
+		{
+			name:  "last die needs the last symbol",
+			q:     OddsQuestion{Picked: []Symbol{Worm, Worm, Bread, Cucumber, Ketchup}, Policy: "most-points", Targets: []int{7}},
+			want:  []ScoreChance{{7, 1.0 / 6}},
+			bust:  5.0 / 6,
+			goals: []ScoreChance{{7, 1.0 / 6}},
+		},
+		{
+			name: "ending without a worm scores 0",
+			q:    OddsQuestion{Picked: []Symbol{Bread, Cucumber}, DiceLeft: 1, Policy: "worm-first"},
+			want: []ScoreChance{{0, 1.0 / 3}, {4, 1.0 / 3}},
+			bust: 1.0 / 3,
+		},
+		{
+			name:  "stopping once the score is reached",
+			q:     OddsQuestion{Picked: []Symbol{Worm, Bread}, DiceLeft: 1, Policy: "most-points", StopAt: 4, Targets: []int{4, 5}},
+			want:  []ScoreChance{{4, 1.0 / 2}},
+			bust:  1.0 / 2,
+			goals: []ScoreChance{{4, 1.0 / 2}, {5, 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.q.Rules = MiniRules
+			if tt.q.DiceLeft == 0 {
+				tt.q.DiceLeft = -1
+			}
+
+			odds, err := ComputeOdds(tt.q)
+			if err != nil {
+				t.Fatalf("ComputeOdds() returned error: %v", err)
+			}
+			if !closeChances(odds.Scores, tt.want) || math.Abs(odds.BustChance-tt.bust) > 1e-9 {
+				t.Errorf("ComputeOdds() = %v with bust %v, want %v with bust %v", odds.Scores, odds.BustChance, tt.want, tt.bust)
+			}
+			if !closeChances(odds.Targets, tt.goals) {
+				t.Errorf("ComputeOdds() targets = %v, want %v", odds.Targets, tt.goals)
+			}
+		})
+	}
+}
+
+func TestComputeOddsPolicies(t *testing.T) {
+	chances := map[string]float64{}
+	for _, policy := range OddsPolicies() {
+		odds, err := ComputeOdds(OddsQuestion{Rules: ClassicRules, DiceLeft: -1, Policy: policy, StopAt: 25, Targets: []int{25}})
+		if err != nil {
+			t.Fatalf("ComputeOdds(%s) returned error: %v", policy, err)
+		}
+
+		total := odds.BustChance
+		for _, sc := range odds.Scores {
+			total += sc.Chance
+		}
+		if math.Abs(total-1) > 1e-9 {
+			t.Errorf("ComputeOdds(%s) chances sum up to %v, want 1", policy, total)
+		}
+		chances[policy] = odds.Targets[0].Chance
+	}
+
+	for _, policy := range OddsPolicies() {
+		if chances[policy] > chances["target"]+1e-9 {
+			t.Errorf("Policy %s reaches 25 with %v, more than the target policy %v", policy, chances[policy], chances["target"])
+		}
+	}
+}
+
+func TestComputeOddsTargetsStopOnceReached(t *testing.T) {
+	for _, policy := range OddsPolicies() {
+		rollOut := OddsQuestion{Rules: MiniRules, Picked: []Symbol{Worm}, DiceLeft: 3, Policy: policy, Targets: []int{3}}
+		stopped := rollOut
+		stopped.StopAt = 3
+
+		got, err := ComputeOdds(rollOut)
+		if err != nil {
+			t.Fatalf("ComputeOdds(%s) returned error: %v", policy, err)
+		}
+		want, _ := ComputeOdds(stopped)
+		if !closeChances(got.Targets, want.Targets) {
+			t.Errorf("ComputeOdds(%s) targets = %v, want the chances of stopping at 3 %v", policy, got.Targets, want.Targets)
+		}
+
+		// Only the target policy stops at the target when the question does not tell when to stop
+		if stops := policy == "target"; stops != (got.StopAt == 3) ||
+			stops && (!closeChances(got.Scores, want.Scores) || got.BustChance != want.BustChance) {
+			t.Errorf("ComputeOdds(%s) stops at %d with %v, stopping at 3 gives %v", policy, got.StopAt, got.Scores, want.Scores)
+		}
+	}
+}
+
+func TestComputeOddsErrors(t *testing.T) {
+	for _, q := range []OddsQuestion{
+		{Rules: MiniRules, DiceLeft: -1, Policy: "unknown"},
+		{Rules: MiniRules, DiceLeft: -1, Policy: "target"},
+		{Rules: MiniRules, DiceLeft: -1, Policy: "most-points", Picked: []Symbol{Tomato}},
+		{Rules: MiniRules, DiceLeft: 5, Policy: "most-points", Picked: []Symbol{Worm, Bread}},
+		{Rules: MiniRules, DiceLeft: 0, Policy: "most-points"},
+	} {
+		if _, err := ComputeOdds(q); !errors.Is(err, ErrInvalidOdds) {
+			t.Errorf("ComputeOdds(%+v) error = %v, want %v", q, err, ErrInvalidOdds)
+		}
+	}
+}
+
+func closeChances(got, want []ScoreChance) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i].Score != want[i].Score || math.Abs(got[i].Chance-want[i].Chance) > 1e-9 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+)
+
+// SimpleParams are the knobs of the simple strategy
+type SimpleParams struct {
+	ThresholdScore int  `json:"thresholdScore"` // keep rolling below this score when no tile can be taken
+	WormFirst      bool `json:"wormFirst"`      // pick worms first while none is picked
+	ValuablePoints int  `json:"valuablePoints"` // pick the symbol worth the most points before the most frequent one, above these points
+	Steal          bool `json:"steal"`          // stop when the score steals the top tile of an opponent
+	TakeLower      bool `json:"takeLower"`      // stop when the score only gets a lower tile
+	RollOnWithDice int  `json:"rollOnWithDice"` // keep rolling for a better tile with at least these dice left, 0 never does
+}
+
+// DefaultSimpleParams are the hand picked parameters the simple strategy has always played with, made for
+// the mini rules
+func DefaultSimpleParams() SimpleParams {
+	return SimpleParams{
+		ThresholdScore: 5, // arbitrary
+		WormFirst:      true,
+		ValuablePoints: 1,
+		Steal:          true,
+		TakeLower:      true,
+		RollOnWithDice: 0,
+	}
+}
+
+// classicSimpleParams were tuned for the classic rules against the easy strategy, with
+// "tune -rules classic -baseline easy -games 200 -candidates 150 -seed 3". The hand picked parameters bust
+// almost every turn with the 8 dice of the classic rules, picking a single die worth 2 points or more.
+var classicSimpleParams = SimpleParams{
+	ThresholdScore: 29,
+	WormFirst:      false,
+	ValuablePoints: 2,
+	Steal:          true,
+	TakeLower:      true,
+	RollOnWithDice: 5,
+}
+
+// DefaultSimpleParamsFor returns the parameters the simple strategy plays with by default under the rules
+func DefaultSimpleParamsFor(rules RuleSet) SimpleParams {
+	if rules.Name == ClassicRules.Name {
+		return classicSimpleParams
+	}
+
+	return DefaultSimpleParams()
+}
+
+// LoadSimpleParams reads parameters from a JSON file. Parameters missing from the file keep their default.
+func LoadSimpleParams(path string) (SimpleParams, error) {
+	params := DefaultSimpleParams()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return params, err
+	}
+	if err = json.Unmarshal(data, &params); err != nil {
+		return params, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return params, nil
+}
+
+// Save writes the parameters to a JSON file
+func (p SimpleParams) Save(path string) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// randomSimpleParams draws parameters within sensible bounds for the rules
+func randomSimpleParams(rnd *rand.Rand, rules RuleSet) SimpleParams {
+	maxScore := rules.DiceCount * maxPoints(rules)
+
+	return SimpleParams{
+		ThresholdScore: 1 + rnd.Intn(maxScore),
+		WormFirst:      rnd.Intn(2) == 0,
+		ValuablePoints: 1 + rnd.Intn(maxPoints(rules)),
+		Steal:          rnd.Intn(2) == 0,
+		TakeLower:      rnd.Intn(2) == 0,
+		RollOnWithDice: rnd.Intn(rules.DiceCount + 1),
+	}
+}
+
+// mutate changes one parameter a little
+func (p SimpleParams) mutate(rnd *rand.Rand, rules RuleSet) SimpleParams {
+	step := 1 - 2*rnd.Intn(2)
+	switch rnd.Intn(6) {
+	case 0:
+		p.ThresholdScore = max(1, min(rules.DiceCount*maxPoints(rules), p.ThresholdScore+step))
+	case 1:
+		p.WormFirst = !p.WormFirst
+	case 2:
+		p.ValuablePoints = max(1, min(maxPoints(rules), p.ValuablePoints+step))
+	case 3:
+		p.Steal = !p.Steal
+	case 4:
+		p.TakeLower = !p.TakeLower
+	case 5:
+		p.RollOnWithDice = max(0, min(rules.DiceCount, p.RollOnWithDice+step))
+	}
+
+	return p
+}
+
+func maxPoints(rules RuleSet) (points int) {
+	for _, p := range rules.Points {
+		points = max(points, p)
+	}
+
+	return max(points, 1)
+}
@@ -0,0 +1,118 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSimpleParamsSaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	params := DefaultSimpleParams()
+	params.ThresholdScore, params.Steal = 8, false
+	path := filepath.Join(dir, "params.json")
+	if err := params.Save(path); err != nil {
+		t.Fatalf("Save() returned error: %v", err)
+	}
+
+	ai, err := NewAIStrategy("simple:" + path)
+	if err != nil {
+		t.Fatalf("NewAIStrategy() returned error: %v", err)
+	}
+	if got := ai.(*SimpleAIStrategy).Params(DefaultRules); got != params {
+		t.Errorf("Loaded params = %+v, want %+v", got, params)
+	}
+	if ai.Name() != "simple:"+path {
+		t.Errorf("Name() = %q, want the params file", ai.Name())
+	}
+
+	partial := filepath.Join(dir, "partial.json")
+	_ = os.WriteFile(partial, []byte(`{"thresholdScore": 3}`), 0o644)
+	want := DefaultSimpleParams()
+	want.ThresholdScore = 3
+	if got, err := LoadSimpleParams(partial); err != nil || got != want {
+		t.Errorf("LoadSimpleParams(partial) = %+v, %v, want %+v", got, err, want)
+	}
+
+	if _, err = NewAIStrategy("simple:" + filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("NewAIStrategy() with a missing params file should fail")
+	}
+}
+
+func TestSimpleAIStrategyParams(t *testing.T) {
+	game := NewGame(DefaultRules, 1)
+	_ = game.Start(0, 2)
+	_, _ = game.board.Take(7)
+	_, _ = game.board.Take(7)
+	game.players[1].tiles.Push(Tile{Value: 7, Worms: 2})
+	game.dice.picked = []Symbol{Worm, Worm, Bread, Bread, Cheese} // 7 with 1 die left
+
+	tests := []struct {
+		name   string
+		modify func(*SimpleParams)
+		want   bool
+	}{
+		{"steal", func(p *SimpleParams) {}, false},
+		{"take a lower tile instead of stealing", func(p *SimpleParams) { p.Steal = false }, false},
+		{"neither steal nor take lower", func(p *SimpleParams) { p.Steal, p.TakeLower, p.ThresholdScore = false, false, 10 }, true},
+		{"roll on with the last die", func(p *SimpleParams) { p.RollOnWithDice = 1 }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultSimpleParams()
+			tt.modify(&params)
+
+			if roll, reasoning := NewSimpleAIStrategyWith(params).ShouldRoll(game.View()); roll != tt.want {
+				t.Errorf("ShouldRoll() = %v (%s), want %v", roll, reasoning, tt.want)
+			}
+		})
+	}
+}
+
+func TestTunerRun(t *testing.T) {
+	tuner := Tuner{Rules: DefaultRules, Baseline: "simple", Games: 20, Candidates: 6, Seed: 2}
+
+	var improvements []int
+	best, rate, err := tuner.Run(func(candidate int, _ SimpleParams, _ float64) {
+		improvements = append(improvements, candidate)
+	})
+	if err != nil {
+		t.Fatalf("Run() returned error: %v", err)
+	}
+
+	if len(improvements) == 0 || improvements[0] != 1 {
+		t.Errorf("Run() improvements at candidates %v, want the defaults first", improvements)
+	}
+	if rate < 0.5 {
+		t.Errorf("Run() best win rate = %f, want at least the 0.5 of the defaults against themselves", rate)
+	}
+
+	again, againRate, _ := tuner.Run(nil)
+	if !reflect.DeepEqual(again, best) || againRate != rate {
+		t.Errorf("Run() with the same seed = %+v at %f, want %+v at %f", again, againRate, best, rate)
+	}
+}
+
+func TestDefaultSimpleParamsByRules(t *testing.T) {
+	for _, rules := range []RuleSet{MiniRules, ClassicRules} {
+		t.Run(rules.Name, func(t *testing.T) {
+			simulation := Simulation{Rules: rules, Strategies: []string{"simple", "easy"}, Games: 200, Seed: 1}
+			result, err := simulation.Run(nil)
+			if err != nil {
+				t.Fatalf("Run() returned error: %v", err)
+			}
+
+			if simple := result.Seats[0]; simple.WinRate() < 0.5 || simple.BustRate() > 0.5 {
+				t.Errorf("simple against easy wins %.1f%% and busts %.1f%% of its turns, want it ahead",
+					100*simple.WinRate(), 100*simple.BustRate())
+			}
+		})
+	}
+
+	if got := NewSimpleAIStrategy().Params(ClassicRules); got != DefaultSimpleParamsFor(ClassicRules) || got == DefaultSimpleParams() {
+		t.Errorf("Params(classic) = %+v, want the classic defaults", got)
+	}
+}
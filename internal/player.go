@@ -17,6 +17,7 @@ type Player struct {
 	mode  PlayerMode
 	tiles *utils.Stack[Tile]
 	ai    AIStrategy
+	hints int // asked during the game
 }
 
 func NewPlayer(mode PlayerMode) Player {
@@ -32,6 +33,30 @@ func NewPlayer(mode PlayerMode) Player {
 	}
 }
 
+// NewAIPlayer creates an AI player taking its decisions with the given strategy
+func NewAIPlayer(ai AIStrategy) Player {
+	p := NewPlayer(AI)
+	p.ai = ai
+
+	return p
+}
+
+// NewPlayers creates the human players followed by one AI player per strategy name, in seat order
+func NewPlayers(humanPlayers int, strategies ...string) (players []Player, err error) {
+	for i := 0; i < humanPlayers; i++ {
+		players = append(players, NewPlayer(Human))
+	}
+	for _, name := range strategies {
+		ai, err := NewAIStrategy(name)
+		if err != nil {
+			return nil, err
+		}
+		players = append(players, NewAIPlayer(ai))
+	}
+
+	return
+}
+
 func (p Player) String() string {
 	var s string
 	topTile, exists := p.tiles.Top()
@@ -44,34 +69,89 @@ func (p Player) String() string {
 	return fmt.Sprintf("[%s]", s)
 }
 
-func (p Player) Score() (worms int, values []int) {
-	tile, popped := p.tiles.Pop()
-	for popped {
-		worms += tile.Worms
-		values = append(values, tile.Value)
+// Scorecard is a read-only summary of the tiles held by a player
+type Scorecard struct {
+	Tiles   []Tile // top tile first
+	Worms   int
+	TopTile Tile
+	HasTile bool
+}
 
-		tile, popped = p.tiles.Pop()
+// Scorecard summarizes the tiles held by the player, without changing them
+func (p Player) Scorecard() (sc Scorecard) {
+	tiles := p.tiles.Items()
+	for i := len(tiles) - 1; i >= 0; i-- {
+		sc.Tiles = append(sc.Tiles, tiles[i])
+		sc.Worms += tiles[i].Worms
 	}
+	sc.TopTile, sc.HasTile = p.tiles.Top()
 
 	return
 }
 
+func (p Player) Score() (worms int, values []int) {
+	sc := p.Scorecard()
+	for _, t := range sc.Tiles {
+		values = append(values, t.Value)
+	}
+
+	return sc.Worms, values
+}
+
 func (p Player) IsAI() bool {
 	return p.mode == AI
 }
 
-func (p Player) AiThink(game *Game) (shouldRoll bool, explanation string) {
+// StrategyName returns the name of the strategy of an AI player, empty for humans
+func (p Player) StrategyName() string {
+	if !p.IsAI() || p.ai == nil {
+		return ""
+	}
+
+	return p.ai.Name()
+}
+
+// StrategyError tells why the strategy of an AI player could not be created when the game was restored,
+// nil when the player plays with it. The simple strategy plays in its place.
+func (p Player) StrategyError() error {
+	if standIn, isStandIn := p.ai.(*standInStrategy); isStandIn {
+		return standIn.err
+	}
+
+	return nil
+}
+
+// Hints returns how many hints the player asked for during the game
+func (p Player) Hints() int {
+	return p.hints
+}
+
+// AiThink asks the strategy whether to roll, with its reasoning quantified. The reasoning is recorded
+// with the next action of the game.
+func (p Player) AiThink(game *Game) (shouldRoll bool, reasoning Reasoning) {
 	if !p.IsAI() || p.ai == nil {
-		return false, ""
+		return false, Reasoning{}
 	}
 
-	return p.ai.ShouldRoll(game)
+	view := game.View()
+	shouldRoll, reasoning = p.ai.ShouldRoll(view)
+	reasoning = reasoning.Quantify(view)
+	game.reasoning = &reasoning
+
+	return shouldRoll, reasoning
 }
 
-func (p Player) AiChoosePick(game *Game) (symbol Symbol, explanation string) {
+// AiChoosePick asks the strategy which symbol to pick, with its reasoning quantified. The reasoning is recorded
+// with the next action of the game.
+func (p Player) AiChoosePick(game *Game) (symbol Symbol, reasoning Reasoning) {
 	if !p.IsAI() || p.ai == nil {
-		return -1, ""
+		return -1, Reasoning{}
 	}
 
-	return p.ai.ChooseSymbol(game)
+	view := game.View()
+	symbol, reasoning = p.ai.ChooseSymbol(view)
+	reasoning = reasoning.Quantify(view)
+	game.reasoning = &reasoning
+
+	return symbol, reasoning
 }
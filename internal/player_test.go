@@ -57,6 +57,14 @@ func TestPlayerScore(t *testing.T) {
 
 	worms, values := player.Score()
 
+	if player.tiles.Len() != 3 {
+		t.Errorf("Score() should not change the player tiles, got %d left", player.tiles.Len())
+	}
+
+	if again, _ := player.Score(); again != worms {
+		t.Errorf("Score() called twice = %d, want %d", again, worms)
+	}
+
 	if worms != 6 {
 		t.Errorf("Score() worms = %d, want 6", worms)
 	}
@@ -72,3 +80,29 @@ func TestPlayerScore(t *testing.T) {
 		}
 	}
 }
+
+func TestPlayerScorecard(t *testing.T) {
+	player := NewPlayer(Human)
+
+	if sc := player.Scorecard(); sc.HasTile || sc.Worms != 0 || len(sc.Tiles) != 0 {
+		t.Errorf("Empty player Scorecard() = %+v, want empty", sc)
+	}
+
+	player.tiles.Push(Tile{Value: 5, Worms: 1})
+	player.tiles.Push(Tile{Value: 9, Worms: 4})
+
+	sc := player.Scorecard()
+
+	if !sc.HasTile || sc.TopTile.Value != 9 {
+		t.Errorf("Scorecard().TopTile = %v, want 9", sc.TopTile)
+	}
+	if sc.Worms != 5 {
+		t.Errorf("Scorecard().Worms = %d, want 5", sc.Worms)
+	}
+	if len(sc.Tiles) != 2 || sc.Tiles[0].Value != 9 || sc.Tiles[1].Value != 5 {
+		t.Errorf("Scorecard().Tiles = %v, want top tile first", sc.Tiles)
+	}
+	if player.tiles.Len() != 2 {
+		t.Errorf("Scorecard() should not change the player tiles")
+	}
+}
@@ -0,0 +1,267 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+var ErrInvalidPolicy = errors.New("invalid policy")
+
+// Policy holds the learned worm swing of rolling and stopping, by state of the turn.
+// States are described by policyState, from what the current player sees.
+type Policy struct {
+	Rules  string                  `json:"rules"` // name of the rule set the policy was learned with
+	Values map[string]ActionValues `json:"values"`
+}
+
+// ActionValues are the learned values of the decisions in a state, with how often each was tried
+type ActionValues struct {
+	Roll       float64 `json:"roll"`
+	Stop       float64 `json:"stop"`
+	RollVisits int     `json:"rollVisits"`
+	StopVisits int     `json:"stopVisits"`
+}
+
+func NewPolicy(rules RuleSet) *Policy {
+	return &Policy{Rules: rules.Name, Values: map[string]ActionValues{}}
+}
+
+// LoadPolicy reads a policy from a JSON file
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var p Policy
+	if err = json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("%s %w: %w", path, ErrInvalidPolicy, err)
+	}
+	if p.Values == nil {
+		return nil, fmt.Errorf("%s %w: no values", path, ErrInvalidPolicy)
+	}
+
+	return &p, nil
+}
+
+// Save writes the policy to a JSON file
+func (p *Policy) Save(path string) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+// value is the best known value of a state, the value of stopping when rolling is unknown or impossible
+func (p *Policy) value(view GameView, picked []Symbol) (v float64, known bool) {
+	after := view
+	after.Picked = picked
+	score, hasWorm := after.PickedScore()
+	stop := view.stopSwing(score, hasWorm)
+	if after.DiceLeft() == 0 {
+		return stop, true
+	}
+
+	values, known := p.Values[policyState(view, picked)]
+	if !known || values.RollVisits == 0 {
+		return stop, known
+	}
+
+	return max(values.Roll, stop), true
+}
+
+// policyState describes the turn of the current player once the symbols are picked: which symbols are picked,
+// the score, the dice left, what stopping gets and busting loses, and the highest tile that can still be taken
+func policyState(view GameView, picked []Symbol) string {
+	after := view
+	after.Picked = picked
+	score, hasWorm := after.PickedScore()
+
+	mask := 0
+	for _, s := range picked {
+		mask |= 1 << s
+	}
+
+	highest := 0
+	for _, t := range view.Board.FaceUp {
+		highest = max(highest, t.Value)
+	}
+	for i := range view.Stacks {
+		if top, exists := view.TopTile(i); i != view.Turn && exists {
+			highest = max(highest, top.Value)
+		}
+	}
+
+	return fmt.Sprintf("%x/%d/%d/%g/%g/%d",
+		mask, score, after.DiceLeft(), view.stopSwing(score, hasWorm), view.bustSwing(), highest)
+}
+
+// afterPick returns the picked dice once every die of the roll showing the symbol is picked too
+func afterPick(view GameView, s Symbol) []Symbol {
+	picked := slices.Clone(view.Picked)
+	for i := 0; i < view.CountInRoll(s); i++ {
+		picked = append(picked, s)
+	}
+
+	return picked
+}
+
+// PolicyStrategy plays by a learned policy. In states the policy does not know, or in games with other rules
+// than the ones the policy was learned with, the fallback strategy decides.
+type PolicyStrategy struct {
+	policy   *Policy
+	source   string // file the policy was loaded from
+	fallback AIStrategy
+}
+
+func NewPolicyStrategy(policy *Policy) *PolicyStrategy {
+	return &PolicyStrategy{policy: policy, fallback: NewSimpleAIStrategy()}
+}
+
+// loadedPolicies keeps the policies read by newPolicyFromArg, so that the strategies of every game share them.
+// Policies are never changed by the strategies playing by them.
+var loadedPolicies = struct {
+	sync.Mutex
+	byPath map[string]loadedPolicy
+}{byPath: map[string]loadedPolicy{}}
+
+type loadedPolicy struct {
+	policy  *Policy
+	modTime time.Time // of the file when it was read, to read it again once it changes
+	size    int64
+}
+
+// sharedPolicy reads the policy of the file, unless it was already read since the file last changed
+func sharedPolicy(path string) (*Policy, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	loadedPolicies.Lock()
+	defer loadedPolicies.Unlock()
+
+	if l, loaded := loadedPolicies.byPath[path]; loaded && l.modTime.Equal(info.ModTime()) && l.size == info.Size() {
+		return l.policy, nil
+	}
+
+	policy, err := LoadPolicy(path)
+	if err != nil {
+		return nil, err
+	}
+	loadedPolicies.byPath[path] = loadedPolicy{policy: policy, modTime: info.ModTime(), size: info.Size()}
+
+	return policy, nil
+}
+
+// newPolicyFromArg creates the strategy with the policy of a JSON file, as in "policy:policy.json"
+func newPolicyFromArg(arg string) (AIStrategy, error) {
+	if arg == "" {
+		return nil, errors.New("missing the policy file")
+	}
+
+	policy, err := sharedPolicy(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	s := NewPolicyStrategy(policy)
+	s.source = arg
+
+	return s, nil
+}
+
+func (s *PolicyStrategy) Name() string {
+	if s.source != "" {
+		return "policy:" + s.source
+	}
+
+	return "policy"
+}
+
+func (s *PolicyStrategy) ShouldRoll(view GameView) (bool, Reasoning) {
+	if len(view.Picked) == 0 {
+		return decideRoll(true, "First roll of the turn")
+	}
+	if view.DiceLeft() == 0 {
+		return decideRoll(false, "Stopping - all dice picked")
+	}
+	if reason := s.mismatch(view); reason != "" {
+		return s.fallbackRoll(view, reason)
+	}
+
+	values, known := s.policy.Values[policyState(view, view.Picked)]
+	if !known || values.RollVisits == 0 || values.StopVisits == 0 {
+		return s.fallbackRoll(view, "Unknown position")
+	}
+
+	options := []OptionValue{{"roll", values.Roll}, {"stop", values.Stop}}
+	if values.Roll > values.Stop {
+		return decideRoll(true, fmt.Sprintf("Rolling - learned %+.2f worms, against %+.2f by stopping (%d tries)",
+			values.Roll, values.Stop, values.RollVisits), options...)
+	}
+
+	return decideRoll(false, fmt.Sprintf("Stopping - learned %+.2f worms, against %+.2f by rolling (%d tries)",
+		values.Stop, values.Roll, values.RollVisits), options...)
+}
+
+func (s *PolicyStrategy) ChooseSymbol(view GameView) (Symbol, Reasoning) {
+	if reason := s.mismatch(view); reason != "" {
+		return s.fallbackPick(view, reason)
+	}
+
+	best, bestValue := Symbol(-1), 0.0
+	var options []OptionValue
+	for _, r := range view.Roll {
+		if !view.CanPick(r) || slices.ContainsFunc(options, func(o OptionValue) bool { return o.Option == r.Name() }) {
+			continue
+		}
+
+		v, known := s.policy.value(view, afterPick(view, r))
+		if !known {
+			continue
+		}
+		options = append(options, OptionValue{r.Name(), v})
+		if best < 0 || v > bestValue {
+			best, bestValue = r, v
+		}
+	}
+
+	if best < 0 {
+		return s.fallbackPick(view, "Unknown position")
+	}
+
+	return decidePick(best, fmt.Sprintf("Picking %s - learned %+.2f worms", best, bestValue), options...)
+}
+
+// mismatch tells why the policy does not fit the game, empty when it was learned with the rules of the game
+func (s *PolicyStrategy) mismatch(view GameView) string {
+	if s.policy.Rules != view.Rules.Name {
+		return fmt.Sprintf("Policy learned with the %s rules, not %s", s.policy.Rules, view.Rules.Name)
+	}
+
+	return ""
+}
+
+// fallbackRoll lets the fallback strategy decide whether to roll, telling why
+func (s *PolicyStrategy) fallbackRoll(view GameView, reason string) (bool, Reasoning) {
+	roll, reasoning := s.fallback.ShouldRoll(view)
+	reasoning.Summary = fmt.Sprintf("%s, the %s strategy decides - %s", reason, s.fallback.Name(), reasoning.Summary)
+
+	return roll, reasoning
+}
+
+// fallbackPick lets the fallback strategy choose the symbol, telling why
+func (s *PolicyStrategy) fallbackPick(view GameView, reason string) (Symbol, Reasoning) {
+	symbol, reasoning := s.fallback.ChooseSymbol(view)
+	reasoning.Summary = fmt.Sprintf("%s, the %s strategy decides - %s", reason, s.fallback.Name(), reasoning.Summary)
+
+	return symbol, reasoning
+}
@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTrainerLearnsLoadablePolicy(t *testing.T) {
+	trainer := Trainer{Rules: DefaultRules, Players: 2, Games: 300, Alpha: 0.05, Epsilon: 0.1, Seed: 3}
+	policy, err := trainer.Run(nil)
+	if err != nil {
+		t.Fatalf("Run() returned error: %v", err)
+	}
+	if len(policy.Values) == 0 {
+		t.Fatalf("Run() learned no state")
+	}
+
+	again, _ := trainer.Run(nil)
+	if !reflect.DeepEqual(policy, again) {
+		t.Errorf("Run() with the same seed learned a different policy")
+	}
+
+	path := filepath.Join(t.TempDir(), "policy.json")
+	if err = policy.Save(path); err != nil {
+		t.Fatalf("Save() returned error: %v", err)
+	}
+
+	ai, err := NewAIStrategy("policy:" + path)
+	if err != nil {
+		t.Fatalf("NewAIStrategy() returned error: %v", err)
+	}
+	if loaded := ai.(*PolicyStrategy).policy; !reflect.DeepEqual(loaded, policy) {
+		t.Errorf("Loaded policy differs from the saved one")
+	}
+
+	game := NewGame(DefaultRules, 5)
+	if err = game.StartWith([]Player{NewAIPlayer(ai), NewAIPlayer(NewSimpleAIStrategy())}); err != nil {
+		t.Fatalf("StartWith() returned error: %v", err)
+	}
+	if err = game.AutoPlay(); err != nil {
+		t.Fatalf("AutoPlay() returned error: %v", err)
+	}
+	if game.State != GameOver {
+		t.Errorf("State = %v, want the game over", game.State)
+	}
+}
+
+func TestPolicyStrategyDecisions(t *testing.T) {
+	game := NewGame(DefaultRules, 1)
+	_ = game.Start(0, 2)
+	game.dice.picked = []Symbol{Worm, Worm, Bread}
+	view := game.View()
+
+	policy := NewPolicy(DefaultRules)
+	ai := NewPolicyStrategy(policy)
+	if _, reasoning := ai.ShouldRoll(view); !strings.HasPrefix(reasoning.Summary, "Unknown position") {
+		t.Errorf("ShouldRoll() in an unknown state = %q, want the fallback to decide", reasoning.Summary)
+	}
+
+	state := policyState(view, view.Picked)
+	for _, tt := range []struct {
+		roll, stop float64
+		want       bool
+	}{
+		{roll: 1.5, stop: 1, want: true},
+		{roll: -0.5, stop: 1, want: false},
+	} {
+		policy.Values[state] = ActionValues{Roll: tt.roll, Stop: tt.stop, RollVisits: 10, StopVisits: 10}
+		if got, reasoning := ai.ShouldRoll(view); got != tt.want || len(reasoning.Options) != 2 {
+			t.Errorf("ShouldRoll() with roll %v and stop %v = %v (%v), want %v", tt.roll, tt.stop, got, reasoning, tt.want)
+		}
+	}
+}
+
+func TestLoadPolicyErrors(t *testing.T) {
+	dir := t.TempDir()
+	invalid := filepath.Join(dir, "invalid.json")
+	_ = os.WriteFile(invalid, []byte(`{"rules": "mini"}`), 0o644)
+
+	if _, err := LoadPolicy(invalid); !errors.Is(err, ErrInvalidPolicy) {
+		t.Errorf("LoadPolicy() without values = %v, want ErrInvalidPolicy", err)
+	}
+	if _, err := NewAIStrategy("policy:" + filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("NewAIStrategy() with a missing policy file should fail")
+	}
+	if _, err := NewAIStrategy("policy"); err == nil {
+		t.Errorf("NewAIStrategy() without a policy file should fail")
+	}
+}
+
+func TestPolicyStrategyOtherRules(t *testing.T) {
+	game := NewGame(ClassicRules, 1)
+	_ = game.Start(0, 2)
+	game.dice.picked = []Symbol{Worm, Bread}
+	view := game.View()
+
+	policy := NewPolicy(DefaultRules)
+	policy.Values[policyState(view, view.Picked)] = ActionValues{Roll: 1, Stop: 0, RollVisits: 10, StopVisits: 10}
+	ai := NewPolicyStrategy(policy)
+	if _, reasoning := ai.ShouldRoll(view); !strings.HasPrefix(reasoning.Summary, "Policy learned with the mini rules") {
+		t.Errorf("ShouldRoll() with other rules = %q, want the fallback to decide", reasoning.Summary)
+	}
+
+	_, _ = game.Roll()
+	if _, reasoning := ai.ChooseSymbol(game.View()); !strings.HasPrefix(reasoning.Summary, "Policy learned with the mini rules") {
+		t.Errorf("ChooseSymbol() with other rules = %q, want the fallback to decide", reasoning.Summary)
+	}
+}
+
+func TestPolicyFileIsLoadedOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	_ = NewPolicy(DefaultRules).Save(path)
+
+	a, errA := NewAIStrategy("policy:" + path)
+	b, errB := NewAIStrategy("policy:" + path)
+	if errA != nil || errB != nil {
+		t.Fatalf("NewAIStrategy() returned errors %v and %v", errA, errB)
+	}
+	if a.(*PolicyStrategy).policy != b.(*PolicyStrategy).policy {
+		t.Errorf("Strategies of the same policy file should share the policy")
+	}
+}
@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Reasoning explains a decision of an AI strategy. The strategy states its choice, why, and the value it gave
+// to every option it weighed. Quantify adds the odds of the position the decision leads to, the same way for
+// every strategy.
+type Reasoning struct {
+	Action  ActionKind    `json:"action"` // roll, stop or pick
+	Symbol  Symbol        `json:"symbol"` // only meaningful for picks
+	Summary string        `json:"summary"`
+	Options []OptionValue `json:"options,omitempty"`
+
+	Score      int     `json:"score"` // of the picked dice, the pick included
+	HasWorm    bool    `json:"hasWorm"`
+	DiceLeft   int     `json:"diceLeft"`
+	BustChance float64 `json:"bustChance"` // of rolling the dice left
+	RollScore  float64 `json:"rollScore"`  // expected score after one more roll picking the most points, busts count 0
+	StopTile   Tile    `json:"stopTile"`   // the tile a stop would get, zero when it busts
+	StopFrom   int     `json:"stopFrom"`   // the player that tile would be stolen from, 0 for the board
+}
+
+// OptionValue is the value a strategy gave to one of its options, in its own unit like worms or points
+type OptionValue struct {
+	Option string  `json:"option"`
+	Value  float64 `json:"value"`
+}
+
+// decideRoll wraps a roll or stop decision with its reasoning
+func decideRoll(roll bool, summary string, options ...OptionValue) (bool, Reasoning) {
+	r := Reasoning{Action: StopAction, Summary: summary, Options: options}
+	if roll {
+		r.Action = RollAction
+	}
+
+	return roll, r
+}
+
+// decidePick wraps a pick decision with its reasoning
+func decidePick(s Symbol, summary string, options ...OptionValue) (Symbol, Reasoning) {
+	return s, Reasoning{Action: PickAction, Symbol: s, Summary: summary, Options: options}
+}
+
+// Quantify computes the odds of the position the decision leads to from the viewed one
+func (r Reasoning) Quantify(view GameView) Reasoning {
+	after := view
+	after.Picked = slices.Clone(view.Picked)
+	after.Roll = nil
+	if r.Action == PickAction && view.CanPick(r.Symbol) {
+		for i := 0; i < view.CountInRoll(r.Symbol); i++ {
+			after.Picked = append(after.Picked, r.Symbol)
+		}
+	}
+
+	r.Score, r.HasWorm = after.PickedScore()
+	r.DiceLeft = after.DiceLeft()
+	r.BustChance, r.RollScore = 0, 0
+	if r.DiceLeft > 0 {
+		solver := newTurnSolver(view.Rules, nil, 0)
+		r.BustChance = solver.bustChance(after.Picked)
+		r.RollScore = solver.rollScore(after.Picked)
+	}
+
+	r.StopTile, r.StopFrom = Tile{}, 0
+	if tile, from, ok := after.TileFor(r.Score); ok && r.HasWorm {
+		r.StopTile, r.StopFrom = tile, from+1
+	}
+
+	return r
+}
+
+func (r Reasoning) String() string {
+	var facts []string
+	if r.Score > 0 {
+		if r.HasWorm {
+			facts = append(facts, fmt.Sprintf("score %d", r.Score))
+		} else {
+			facts = append(facts, fmt.Sprintf("score %d without worms", r.Score))
+		}
+	}
+
+	if r.DiceLeft > 0 {
+		facts = append(facts, fmt.Sprintf("%.0f%% bust chance", 100*r.BustChance),
+			fmt.Sprintf("%.1f expected by rolling", r.RollScore))
+	}
+
+	if r.Score > 0 {
+		switch {
+		case r.StopTile.Value == 0:
+			facts = append(facts, "stopping busts")
+		case r.StopFrom > 0:
+			facts = append(facts, fmt.Sprintf("stopping steals %d from P%d", r.StopTile.Value, r.StopFrom))
+		default:
+			facts = append(facts, fmt.Sprintf("stopping takes %d", r.StopTile.Value))
+		}
+	}
+
+	if len(facts) == 0 {
+		return r.Summary
+	}
+
+	return fmt.Sprintf("%s (%s)", r.Summary, strings.Join(facts, ", "))
+}
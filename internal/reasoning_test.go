@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"math"
+	"testing"
+)
+
+func TestReasoningQuantify(t *testing.T) {
+	game := newScriptedGame()
+
+	tests := []struct {
+		name       string
+		picked     []Symbol
+		roll       []Symbol
+		reasoning  Reasoning
+		want       Reasoning
+		wantString string
+	}{
+		{
+			name:       "stop with four worms",
+			picked:     []Symbol{Worm, Worm, Worm, Worm},
+			reasoning:  Reasoning{Action: StopAction, Summary: "Enough"},
+			want:       Reasoning{Score: 4, HasWorm: true, DiceLeft: 2, BustChance: 1.0 / 9, StopTile: Tile{Value: 4, Worms: 1}},
+			wantString: "Enough (score 4, 11% bust chance, 4.9 expected by rolling, stopping takes 4)",
+		},
+		{
+			name:       "roll the last die",
+			picked:     []Symbol{Worm, Worm, Bread, Cucumber, Ketchup},
+			reasoning:  Reasoning{Action: RollAction, Summary: "Go"},
+			want:       Reasoning{Score: 6, HasWorm: true, DiceLeft: 1, BustChance: 5.0 / 6, RollScore: 7.0 / 6, StopTile: Tile{Value: 6, Worms: 2}},
+			wantString: "Go (score 6, 83% bust chance, 1.2 expected by rolling, stopping takes 6)",
+		},
+		{
+			name:      "pick the worm",
+			picked:    []Symbol{Bread, Bread},
+			roll:      []Symbol{Worm, Cheese, Worm},
+			reasoning: Reasoning{Action: PickAction, Symbol: Worm, Summary: "Worms"},
+			want:      Reasoning{Score: 6, HasWorm: true, DiceLeft: 2, BustChance: 1.0 / 4, StopTile: Tile{Value: 6, Worms: 2}},
+		},
+		{
+			name:       "first roll",
+			reasoning:  Reasoning{Action: RollAction, Summary: "First roll of the turn"},
+			want:       Reasoning{DiceLeft: 6},
+			wantString: "First roll of the turn (0% bust chance, 3.2 expected by rolling)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game.dice.picked, game.dice.roll = tt.picked, tt.roll
+			got := tt.reasoning.Quantify(game.View())
+
+			if got.Score != tt.want.Score || got.HasWorm != tt.want.HasWorm || got.DiceLeft != tt.want.DiceLeft ||
+				got.StopTile != tt.want.StopTile || got.StopFrom != tt.want.StopFrom {
+				t.Errorf("Quantify() = %+v, want %+v", got, tt.want)
+			}
+			if math.Abs(got.BustChance-tt.want.BustChance) > 1e-9 {
+				t.Errorf("Quantify().BustChance = %f, want %f", got.BustChance, tt.want.BustChance)
+			}
+			if tt.want.RollScore > 0 && math.Abs(got.RollScore-tt.want.RollScore) > 1e-9 {
+				t.Errorf("Quantify().RollScore = %f, want %f", got.RollScore, tt.want.RollScore)
+			}
+			if tt.wantString != "" && got.String() != tt.wantString {
+				t.Errorf("String() = %q, want %q", got.String(), tt.wantString)
+			}
+		})
+	}
+}
+
+func TestStrategiesReasoning(t *testing.T) {
+	game := newScriptedGame(0, 2, 2, 3, 5, 5)
+	_, _ = game.Roll()
+
+	for _, name := range []string{"easy", "simple", "expectimax", "montecarlo:20"} {
+		t.Run(name, func(t *testing.T) {
+			ai, _ := NewAIStrategy(name)
+			player := NewAIPlayer(ai)
+
+			symbol, reasoning := player.AiChoosePick(game)
+			if reasoning.Action != PickAction || reasoning.Symbol != symbol || reasoning.Summary == "" {
+				t.Errorf("AiChoosePick() reasoning = %+v, want the pick of %v explained", reasoning, symbol)
+			}
+			if reasoning.DiceLeft == 0 || reasoning.DiceLeft == DefaultRules.DiceCount {
+				t.Errorf("AiChoosePick() reasoning should be quantified after the pick, got %+v", reasoning)
+			}
+		})
+	}
+}
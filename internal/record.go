@@ -0,0 +1,189 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrInvalidAction  = errors.New("not a valid action")
+	ErrReplayMismatch = errors.New("replayed game does not match the record")
+)
+
+// ActionKind is a decision a player can take during a turn
+type ActionKind int
+
+const (
+	RollAction ActionKind = iota
+	PickAction
+	StopAction
+	NextTurnAction
+)
+
+func (k ActionKind) String() string {
+	switch k {
+	case RollAction:
+		return "roll"
+	case PickAction:
+		return "pick"
+	case StopAction:
+		return "stop"
+	case NextTurnAction:
+		return "next"
+	default:
+		return "unknown"
+	}
+}
+
+func (k ActionKind) MarshalText() ([]byte, error) {
+	if k < RollAction || k > NextTurnAction {
+		return nil, ErrInvalidAction
+	}
+
+	return []byte(k.String()), nil
+}
+
+func (k *ActionKind) UnmarshalText(text []byte) error {
+	for kind := RollAction; kind <= NextTurnAction; kind++ {
+		if strings.EqualFold(kind.String(), string(text)) {
+			*k = kind
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s %w", text, ErrInvalidAction)
+}
+
+// Action is a decision taken by the current player. Symbol is only meaningful for picks.
+// Reasoning is the explanation an AI player gave for the decision, kept so that replays show it again.
+type Action struct {
+	Player    int        `json:"player"`
+	Kind      ActionKind `json:"kind"`
+	Symbol    Symbol     `json:"symbol"`
+	Reasoning *Reasoning `json:"reasoning,omitempty"`
+}
+
+func (a Action) String() string {
+	if a.Kind == PickAction {
+		return fmt.Sprintf("P%d %s %s", a.Player, a.Kind, a.Symbol)
+	}
+
+	return fmt.Sprintf("P%d %s", a.Player, a.Kind)
+}
+
+// Record holds what is needed to replay a game: its setup, the decisions taken and the state they led to
+type Record struct {
+	Rules   RuleSet          `json:"rules"`
+	Seed    int64            `json:"seed"`
+	Players []PlayerSnapshot `json:"players"` // modes, strategies and hints asked, tiles are left empty
+	Actions []Action         `json:"actions"`
+	Final   Snapshot         `json:"final"`
+}
+
+// Actions returns every decision taken in the current game, oldest first
+func (g *Game) Actions() []Action {
+	actions := make([]Action, len(g.actions))
+	copy(actions, g.actions)
+
+	return actions
+}
+
+// Record captures the current game so that it can be replayed
+func (g *Game) Record() Record {
+	r := Record{
+		Rules:   g.rules,
+		Seed:    g.seed,
+		Actions: g.Actions(),
+		Final:   g.Snapshot(),
+	}
+
+	for _, p := range r.Final.Players {
+		r.Players = append(r.Players, PlayerSnapshot{Mode: p.Mode, Strategy: p.Strategy, Hints: p.Hints})
+	}
+
+	return r
+}
+
+// Apply takes the decision on behalf of the current player
+func (g *Game) Apply(a Action) (err error) {
+	switch a.Kind {
+	case RollAction:
+		_, err = g.Roll()
+	case PickAction:
+		err = g.Pick(a.Symbol)
+	case StopAction:
+		err = g.StopTurn()
+	case NextTurnAction:
+		if g.State != GameLoop {
+			return ErrGameOver
+		}
+		g.NextTurn()
+	default:
+		err = fmt.Errorf("%v %w", a.Kind, ErrInvalidAction)
+	}
+
+	return
+}
+
+// Replay rebuilds a recorded game decision by decision, then checks that the board and the players
+// tiles match the recorded final state. The observer, if not nil, sees the game before every action.
+// The strategies of the AI players are only named, they are not created since the decisions are recorded.
+func Replay(r Record, observe func(g *Game, a Action)) (*Game, error) {
+	g := NewGame(r.Rules, r.Seed)
+
+	var players []Player
+	for _, ps := range r.Players {
+		p := NewPlayer(ps.Mode)
+		if ps.Mode == AI && ps.Strategy != "" {
+			p.ai = newStandInStrategy(ps.Strategy, nil)
+		}
+		p.hints = ps.Hints // hints are not part of the actions, the recorded counts are kept as they are
+		players = append(players, p)
+	}
+
+	if err := g.StartWith(players); err != nil {
+		return nil, err
+	}
+
+	for i, a := range r.Actions {
+		if a.Player != g.turn+1 {
+			return g, fmt.Errorf("%w: action #%d %v during the turn of P%d", ErrReplayMismatch, i+1, a, g.turn+1)
+		}
+
+		g.replayHints(r.Final.Hints, i)
+		if observe != nil {
+			observe(g, a)
+		}
+
+		if err := g.Apply(a); err != nil {
+			return g, fmt.Errorf("%w: action #%d %v: %w", ErrReplayMismatch, i+1, a, err)
+		}
+	}
+
+	g.replayHints(r.Final.Hints, len(r.Actions))
+
+	got, want := g.Snapshot(), r.Final
+	if !slices.Equal(got.Board.FaceUp, want.Board.FaceUp) || !slices.Equal(got.Board.FaceDown, want.Board.FaceDown) {
+		return g, fmt.Errorf("%w: board %v, recorded %v", ErrReplayMismatch, got.Board, want.Board)
+	}
+	if len(got.Players) != len(want.Players) {
+		return g, fmt.Errorf("%w: %d players, recorded %d", ErrReplayMismatch, len(got.Players), len(want.Players))
+	}
+	for i := range want.Players {
+		if !slices.Equal(got.Players[i].Tiles, want.Players[i].Tiles) {
+			return g, fmt.Errorf("%w: P%d tiles %v, recorded %v",
+				ErrReplayMismatch, i+1, got.Players[i].Tiles, want.Players[i].Tiles)
+		}
+	}
+
+	return g, nil
+}
+
+func (g *Game) act(a Action) {
+	a.Player = g.turn + 1
+	a.Reasoning, g.reasoning = g.reasoning, nil
+	g.actions = append(g.actions, a)
+}
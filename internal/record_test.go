@@ -0,0 +1,181 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestReplay(t *testing.T) {
+	game := NewGame(DefaultRules, 2024)
+	_ = game.Start(2, 1)
+	playTurns(game, 1000)
+
+	if game.State != GameOver {
+		t.Fatalf("Game should be over, got state %v", game.State)
+	}
+
+	data, err := json.Marshal(game.Record())
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+
+	var record Record
+	if err = json.Unmarshal(data, &record); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+
+	var observed int
+	replayed, err := Replay(record, func(g *Game, a Action) {
+		if a.Player != g.turn+1 {
+			t.Errorf("Observed action %v during the turn of P%d", a, g.turn+1)
+		}
+		observed++
+	})
+	if err != nil {
+		t.Fatalf("Replay() returned error: %v", err)
+	}
+
+	if observed != len(record.Actions) {
+		t.Errorf("Replay() observed %d actions, want %d", observed, len(record.Actions))
+	}
+
+	if !reflect.DeepEqual(replayed.Standings(), game.Standings()) {
+		t.Errorf("Replayed standings = %v, want %v", replayed.Standings(), game.Standings())
+	}
+
+	if !reflect.DeepEqual(replayed.History(), game.History()) {
+		t.Errorf("Replayed history differs from the original one")
+	}
+}
+
+func TestReplayMismatch(t *testing.T) {
+	game := NewGame(DefaultRules, 7)
+	_ = game.Start(2, 0)
+	playTurns(game, 4)
+
+	tests := []struct {
+		name   string
+		modify func(*Record)
+	}{
+		{"different seed", func(r *Record) { r.Seed++ }},
+		{"tampered final board", func(r *Record) { r.Final.Board.FaceUp = r.Final.Board.FaceUp[1:] }},
+		{"tampered final tiles", func(r *Record) { r.Final.Players[0].Tiles = append(r.Final.Players[0].Tiles, Tile{Value: 9}) }},
+		{"action out of turn", func(r *Record) { r.Actions[0].Player = 2 }},
+		{"illegal action", func(r *Record) { r.Actions = append([]Action{{Player: 1, Kind: StopAction}}, r.Actions...) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := game.Record()
+			tt.modify(&record)
+
+			if _, err := Replay(record, nil); !errors.Is(err, ErrReplayMismatch) {
+				t.Errorf("Replay() error = %v, want %v", err, ErrReplayMismatch)
+			}
+		})
+	}
+}
+
+func TestActionKindText(t *testing.T) {
+	for kind := RollAction; kind <= NextTurnAction; kind++ {
+		text, err := kind.MarshalText()
+		if err != nil {
+			t.Fatalf("%v.MarshalText() returned error: %v", kind, err)
+		}
+
+		var got ActionKind
+		if err = got.UnmarshalText(text); err != nil || got != kind {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", text, got, err, kind)
+		}
+	}
+
+	var k ActionKind
+	if err := k.UnmarshalText([]byte("jump")); !errors.Is(err, ErrInvalidAction) {
+		t.Errorf("UnmarshalText(jump) error = %v, want %v", err, ErrInvalidAction)
+	}
+}
+
+func TestHintsAreRecorded(t *testing.T) {
+	game := newScriptedGame(0, 0, 2, 2, 3, 5, 2, 3, 4, 5)
+
+	hint, err := game.Hint(NewExpectimaxAIStrategy())
+	if err != nil || hint.Action != RollAction {
+		t.Fatalf("Hint() before rolling = %v, %v, want a roll", hint, err)
+	}
+
+	_, _ = game.Roll()
+	hint, err = game.Hint(NewExpectimaxAIStrategy())
+	if err != nil || hint.Action != PickAction || !game.dice.CanPick(hint.Symbol) {
+		t.Fatalf("Hint() after rolling = %v, %v, want a possible pick", hint, err)
+	}
+	if len(game.Actions()) != 1 {
+		t.Errorf("Hint() should not act, got actions %v", game.Actions())
+	}
+
+	record := game.Record()
+	if record.Players[0].Hints != 2 || record.Players[1].Hints != 0 {
+		t.Errorf("Recorded hints = %d and %d, want 2 and 0", record.Players[0].Hints, record.Players[1].Hints)
+	}
+
+	var restored Game
+	data, _ := json.Marshal(game)
+	if err = json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+	if hints := restored.CurrentPlayer().Hints(); hints != 2 {
+		t.Errorf("Restored hints = %d, want 2", hints)
+	}
+
+	game.Stop()
+	if _, err = game.Hint(NewExpectimaxAIStrategy()); !errors.Is(err, ErrGameOver) {
+		t.Errorf("Hint() once the game is over error = %v, want %v", err, ErrGameOver)
+	}
+}
+
+func TestAIReasoningIsRecorded(t *testing.T) {
+	game := NewGame(DefaultRules, 7)
+	_ = game.Start(0, 2)
+
+	player := game.CurrentPlayer()
+	_, thought := player.AiThink(game)
+	_, _ = game.Roll()
+	_ = game.Pick(game.dice.roll[0])
+
+	data, err := json.Marshal(game.Record())
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+
+	var record Record
+	if err = json.Unmarshal(data, &record); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+
+	if got := record.Actions[0].Reasoning; got == nil || !reflect.DeepEqual(*got, thought) {
+		t.Errorf("Recorded reasoning of the roll = %v, want %v", got, thought)
+	}
+	if got := record.Actions[1].Reasoning; got != nil {
+		t.Errorf("Recorded reasoning of a pick nobody explained = %v, want none", got)
+	}
+}
+
+func TestReplayDoesNotCreateStrategies(t *testing.T) {
+	game := NewGame(DefaultRules, 11)
+	_ = game.StartWith([]Player{NewAIPlayer(NewSimpleAIStrategy()), NewAIPlayer(NewEasyAIStrategy())})
+	if err := game.AutoPlay(); err != nil {
+		t.Fatalf("AutoPlay() returned error: %v", err)
+	}
+
+	record := game.Record()
+	record.Players[0].Strategy = "policy:/missing/policy.json"
+
+	replayed, err := Replay(record, nil)
+	if err != nil {
+		t.Fatalf("Replay() with a strategy missing on this machine returned error: %v", err)
+	}
+	if name := replayed.Players()[0].StrategyName(); name != "policy:/missing/policy.json" {
+		t.Errorf("Replayed strategy name = %q, want the recorded one", name)
+	}
+}
@@ -0,0 +1,142 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrUnknownRuleSet = errors.New("unknown rule set")
+
+// RuleSet defines a variant of the game: the dice, how picked symbols score, the tiles and the player limits
+type RuleSet struct {
+	Name       string         `json:"name"`
+	DiceCount  int            `json:"diceCount"`
+	Faces      []Symbol       `json:"faces"`  // symbols printed on the faces of every die, each face equally likely
+	Points     map[Symbol]int `json:"points"` // points scored by every picked die showing the symbol
+	Tiles      []Tile         `json:"tiles"`
+	MinPlayers int            `json:"minPlayers"`
+	MaxPlayers int            `json:"maxPlayers"`
+}
+
+// MiniRules is the short game: 6 dice with two worm faces, bread counts double, tiles from 4 to 9
+var MiniRules = RuleSet{
+	Name:      "mini",
+	DiceCount: DefaultDiceCount,
+	Faces:     []Symbol{Worm, Worm, Bread, Cucumber, Ketchup, Cheese},
+	Points: map[Symbol]int{
+		Worm:     1,
+		Bread:    2,
+		Cucumber: 1,
+		Ketchup:  1,
+		Cheese:   1,
+	},
+	Tiles: []Tile{
+		{Value: 4, Worms: 1},
+		{Value: 4, Worms: 1},
+		{Value: 5, Worms: 1},
+		{Value: 5, Worms: 1},
+		{Value: 6, Worms: 2},
+		{Value: 6, Worms: 2},
+		{Value: 7, Worms: 2},
+		{Value: 7, Worms: 2},
+		{Value: 8, Worms: 3},
+		{Value: 8, Worms: 3},
+		{Value: 9, Worms: 4},
+		{Value: 9, Worms: 4},
+	},
+	MinPlayers: 2,
+	MaxPlayers: 4,
+}
+
+// ClassicRules is the original game: 8 dice showing 1 to 5 and a worm worth 5, tiles from 21 to 36
+var ClassicRules = RuleSet{
+	Name:      "classic",
+	DiceCount: 8,
+	Faces:     []Symbol{Worm, Bread, Cucumber, Ketchup, Cheese, Tomato},
+	Points: map[Symbol]int{
+		Worm:     5,
+		Bread:    5,
+		Cucumber: 4,
+		Ketchup:  3,
+		Cheese:   2,
+		Tomato:   1,
+	},
+	Tiles: []Tile{
+		{Value: 21, Worms: 1},
+		{Value: 22, Worms: 1},
+		{Value: 23, Worms: 1},
+		{Value: 24, Worms: 1},
+		{Value: 25, Worms: 2},
+		{Value: 26, Worms: 2},
+		{Value: 27, Worms: 2},
+		{Value: 28, Worms: 2},
+		{Value: 29, Worms: 3},
+		{Value: 30, Worms: 3},
+		{Value: 31, Worms: 3},
+		{Value: 32, Worms: 3},
+		{Value: 33, Worms: 4},
+		{Value: 34, Worms: 4},
+		{Value: 35, Worms: 4},
+		{Value: 36, Worms: 4},
+	},
+	MinPlayers: 2,
+	MaxPlayers: 7,
+}
+
+// DefaultRules are used whenever no rule set is chosen
+var DefaultRules = MiniRules
+
+// RuleSets lists the built-in rule set presets
+func RuleSets() []RuleSet {
+	return []RuleSet{MiniRules, ClassicRules}
+}
+
+// RuleSetByName returns the built-in rule set preset with the given name
+func RuleSetByName(name string) (RuleSet, error) {
+	for _, r := range RuleSets() {
+		if strings.EqualFold(r.Name, strings.TrimSpace(name)) {
+			return r, nil
+		}
+	}
+
+	return RuleSet{}, fmt.Errorf("%s %w", name, ErrUnknownRuleSet)
+}
+
+// WithDefaults fills in what the rule set leaves unset with the values of the default rules, field by field
+func (r RuleSet) WithDefaults() RuleSet {
+	if r.DiceCount <= 0 {
+		r.DiceCount = DefaultDiceCount
+	}
+	if len(r.Faces) == 0 {
+		r.Faces = DefaultRules.Faces
+	}
+	if len(r.Points) == 0 {
+		r.Points = DefaultRules.Points
+	}
+	if len(r.Tiles) == 0 {
+		r.Tiles = DefaultRules.Tiles
+	}
+	if r.MinPlayers <= 0 {
+		r.MinPlayers = DefaultRules.MinPlayers
+	}
+	if r.MaxPlayers <= 0 {
+		r.MaxPlayers = max(r.MinPlayers, DefaultRules.MaxPlayers)
+	}
+
+	return r
+}
+
+// Symbols returns the distinct symbols on the dice faces, in face order
+func (r RuleSet) Symbols() (symbols []Symbol) {
+	seen := map[Symbol]struct{}{}
+	for _, f := range r.Faces {
+		if _, exists := seen[f]; exists {
+			continue
+		}
+		seen[f] = struct{}{}
+		symbols = append(symbols, f)
+	}
+
+	return
+}
@@ -0,0 +1,56 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRuleSetByName(t *testing.T) {
+	tests := []struct {
+		name     string
+		wantDice int
+		wantErr  error
+	}{
+		{"mini", 6, nil},
+		{"Classic", 8, nil},
+		{"huge", 0, ErrUnknownRuleSet},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := RuleSetByName(tt.name)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RuleSetByName(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+			if rules.DiceCount != tt.wantDice {
+				t.Errorf("RuleSetByName(%q).DiceCount = %d, want %d", tt.name, rules.DiceCount, tt.wantDice)
+			}
+		})
+	}
+}
+
+func TestRuleSetSymbols(t *testing.T) {
+	if got := MiniRules.Symbols(); len(got) != 5 || got[0] != Worm {
+		t.Errorf("MiniRules.Symbols() = %v, want 5 symbols starting with Worm", got)
+	}
+
+	if got := ClassicRules.Symbols(); len(got) != 6 {
+		t.Errorf("ClassicRules.Symbols() = %v, want 6 symbols", got)
+	}
+}
+
+func TestRuleSetPresetsAreConsistent(t *testing.T) {
+	for _, r := range RuleSets() {
+		for _, f := range r.Faces {
+			if _, exists := r.Points[f]; !exists {
+				t.Errorf("%s rules: face %v has no points", r.Name, f)
+			}
+		}
+		if r.MinPlayers < 2 || r.MinPlayers > r.MaxPlayers {
+			t.Errorf("%s rules: invalid player limits %d-%d", r.Name, r.MinPlayers, r.MaxPlayers)
+		}
+		if len(r.Tiles) == 0 {
+			t.Errorf("%s rules: no tiles", r.Name)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package internal
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// Simulation plays AI-only games between the same strategies, one per seat, without any delay or input.
+// The games are spread across a pool of workers. Every game gets its own dice stream, seeded from the seed and
+// the game number, so that the result is the same whatever the number of workers.
+type Simulation struct {
+	Rules      RuleSet
+	Strategies []string // by seat
+	Games      int
+	Seed       int64
+	Workers    int                   // games played at the same time, 0 for one per CPU
+	Export     func(GameStats) error // if not nil, called with the stats of every game in game order, from a single goroutine
+}
+
+type SimulationResult struct {
+	Seats []StrategyStats // by seat
+	Games int
+	Turns int // played in all the games
+}
+
+// AverageTurns is the average length of a game, in turns of all the players
+func (r SimulationResult) AverageTurns() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+
+	return float64(r.Turns) / float64(r.Games)
+}
+
+// WorkerCount is the number of games Run plays at the same time: the workers asked for, one per CPU when
+// none are, and never more than the games
+func (s Simulation) WorkerCount() int {
+	workers := s.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	return max(min(workers, s.Games), 1)
+}
+
+// Run plays all the games. The progress function, if not nil, is called after every game.
+func (s Simulation) Run(progress func(played, total int)) (result SimulationResult, err error) {
+	if s.Games <= 0 {
+		return result, fmt.Errorf("%w: at least one game is needed", ErrTournamentSetup)
+	}
+	if len(s.Strategies) < s.Rules.MinPlayers || len(s.Strategies) > s.Rules.MaxPlayers {
+		return result, fmt.Errorf("%w: %d players, the %s rules allow %d to %d", ErrTournamentSetup,
+			len(s.Strategies), s.Rules.Name, s.Rules.MinPlayers, s.Rules.MaxPlayers)
+	}
+	for _, name := range s.Strategies {
+		if _, err = NewAIStrategy(name); err != nil {
+			return result, fmt.Errorf("%w: %w", ErrTournamentSetup, err)
+		}
+		result.Seats = append(result.Seats, StrategyStats{Strategy: name})
+	}
+
+	workers := s.WorkerCount()
+
+	// Workers take the game numbers to play from the jobs, and send the stats back to the aggregator below.
+	// Closing done stops them early, once the aggregator gave up on an error.
+	jobs := make(chan int)
+	results := make(chan simulatedGame)
+	done := make(chan struct{})
+	defer close(done)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				stats, err := s.play(i)
+				select {
+				case results <- simulatedGame{i, stats, err}:
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for i := 0; i < s.Games; i++ {
+			select {
+			case jobs <- i:
+			case <-done:
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// Games finish in any order, they are aggregated in game order so that the result does not depend on the workers
+	finished := map[int]GameStats{}
+	for r := range results {
+		if r.err != nil {
+			return result, fmt.Errorf("game %d: %w", r.index+1, r.err)
+		}
+
+		finished[r.index] = r.stats
+		for game, ok := finished[result.Games]; ok; game, ok = finished[result.Games] {
+			delete(finished, result.Games)
+			result.Games++
+			result.Turns += game.Turns
+			for seat := range result.Seats {
+				result.Seats[seat].add(game, seat)
+			}
+			if s.Export != nil {
+				if err = s.Export(game); err != nil {
+					return result, err
+				}
+			}
+
+			if progress != nil {
+				progress(result.Games, s.Games)
+			}
+		}
+	}
+
+	return
+}
+
+type simulatedGame struct {
+	index int
+	stats GameStats
+	err   error
+}
+
+// play plays the game with the given number. Every game has its own strategies and its own dice, seeded
+// from the game number, so that games can be played at the same time.
+func (s Simulation) play(i int) (GameStats, error) {
+	var strategies []AIStrategy
+	for _, name := range s.Strategies {
+		ai, err := NewAIStrategy(name)
+		if err != nil {
+			return GameStats{}, err
+		}
+		strategies = append(strategies, ai)
+	}
+
+	return playAIGame(s.Rules, s.Seed+int64(i), strategies)
+}
@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSimulationRun(t *testing.T) {
+	simulation := Simulation{Rules: DefaultRules, Strategies: []string{"easy", "simple", "simple"}, Games: 30, Seed: 8}
+
+	var progressed int
+	result, err := simulation.Run(func(played, total int) { progressed = played })
+	if err != nil {
+		t.Fatalf("Run() returned error: %v", err)
+	}
+	if progressed != 30 || result.Games != 30 {
+		t.Errorf("Run() played %d games and reported %d, want 30", result.Games, progressed)
+	}
+
+	wins := 0
+	for i, s := range result.Seats {
+		if s.Strategy != simulation.Strategies[i] || s.Games != 30 {
+			t.Errorf("Seat %d stats = %+v, want 30 games of %s", i+1, s, simulation.Strategies[i])
+		}
+		wins += s.Wins
+	}
+	if wins > 30 || result.AverageTurns() <= 0 {
+		t.Errorf("Run() counted %d wins and %.1f turns per game for 30 games", wins, result.AverageTurns())
+	}
+
+	again, _ := simulation.Run(nil)
+	if !reflect.DeepEqual(result, again) {
+		t.Errorf("Run() with the same seed = %+v, want %+v", again, result)
+	}
+
+	collect := func(into *[]GameStats) func(GameStats) error {
+		return func(s GameStats) error {
+			*into = append(*into, s)
+
+			return nil
+		}
+	}
+	var sequential, parallel []GameStats
+	simulation.Workers, simulation.Export = 1, collect(&sequential)
+	single, _ := simulation.Run(nil)
+	simulation.Workers, simulation.Export = 4, collect(&parallel)
+	pooled, _ := simulation.Run(nil)
+	if !reflect.DeepEqual(single, pooled) || !reflect.DeepEqual(sequential, parallel) {
+		t.Errorf("Run() with 4 workers = %+v, want the result of a single worker %+v", pooled, single)
+	}
+	for i, s := range parallel {
+		if s.Seed != simulation.Seed+int64(i) {
+			t.Errorf("Exported game %d has seed %d, want the games in order", i+1, s.Seed)
+		}
+	}
+
+	failing := errors.New("disk full")
+	simulation.Export = func(GameStats) error { return failing }
+	if _, err = simulation.Run(nil); !errors.Is(err, failing) {
+		t.Errorf("Run() with a failing export error = %v, want %v", err, failing)
+	}
+	simulation.Export = nil
+
+	for _, strategies := range [][]string{{"simple"}, {"simple", "simple", "simple", "simple", "simple"}, {"simple", "unknown"}} {
+		simulation.Strategies = strategies
+		if _, err = simulation.Run(nil); !errors.Is(err, ErrTournamentSetup) {
+			t.Errorf("Run() with strategies %v error = %v, want %v", strategies, err, ErrTournamentSetup)
+		}
+	}
+}
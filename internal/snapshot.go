@@ -0,0 +1,203 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"regenwormen/pkg/utils"
+)
+
+var ErrInvalidSnapshot = errors.New("invalid game snapshot")
+
+// Snapshot is the complete state of a game, so that it can be saved and resumed later.
+// The event history is not stored, it is rebuilt from the decisions taken so far and the hints asked.
+type Snapshot struct {
+	Rules   RuleSet          `json:"rules"`
+	Seed    int64            `json:"seed"`
+	Throws  int              `json:"throws"` // dice throws made since seeding, to resume with the same throws
+	State   GameState        `json:"state"`
+	Turn    int              `json:"turn"`
+	TurnNr  int              `json:"turnNr"`
+	Phase   TurnPhase        `json:"phase"`
+	Board   BoardSnapshot    `json:"board"`
+	Players []PlayerSnapshot `json:"players"`
+	Roll    []Symbol         `json:"roll"`
+	Picked  []Symbol         `json:"picked"`
+	Actions []Action         `json:"actions"`
+	Hints   []HintSnapshot   `json:"hints,omitempty"`
+}
+
+type BoardSnapshot struct {
+	FaceUp   []Tile `json:"faceUp"`
+	FaceDown []Tile `json:"faceDown"`
+}
+
+type PlayerSnapshot struct {
+	Mode     PlayerMode `json:"mode"`
+	Strategy string     `json:"strategy,omitempty"`
+	Tiles    []Tile     `json:"tiles"` // bottom of the stack first
+	Hints    int        `json:"hints,omitempty"`
+}
+
+// HintSnapshot is a hint asked during the game, with its place among the actions
+type HintSnapshot struct {
+	Turn     int        `json:"turn"`
+	Player   int        `json:"player"`
+	Strategy string     `json:"strategy"`
+	Advice   ActionKind `json:"advice"`
+	Symbol   Symbol     `json:"symbol"`
+	After    int        `json:"after"` // actions taken in the game when the hint was asked
+}
+
+func (h HintSnapshot) event() HintEvent {
+	return HintEvent{Turn: h.Turn, Player: h.Player, Strategy: h.Strategy, Advice: h.Advice, Symbol: h.Symbol}
+}
+
+// Snapshot captures the current state of the game
+func (g *Game) Snapshot() Snapshot {
+	s := Snapshot{
+		Rules:   g.rules,
+		Seed:    g.seed,
+		State:   g.State,
+		Turn:    g.turn,
+		TurnNr:  g.turnNr,
+		Phase:   g.phase,
+		Roll:    append([]Symbol(nil), g.dice.roll...),
+		Picked:  append([]Symbol(nil), g.dice.picked...),
+		Actions: g.Actions(),
+		Hints:   slices.Clone(g.hints),
+	}
+
+	if roller, isSeeded := g.dice.roller.(*SeededRoller); isSeeded {
+		s.Throws = roller.Throws()
+	}
+
+	for i := g.board.min; i <= g.board.max; i++ {
+		s.Board.FaceUp = append(s.Board.FaceUp, g.board.tiles[i]...)
+		s.Board.FaceDown = append(s.Board.FaceDown, g.board.faceDown[i]...)
+	}
+
+	for _, p := range g.players {
+		ps := PlayerSnapshot{Mode: p.mode, Tiles: p.tiles.Items(), Hints: p.hints}
+		if p.ai != nil {
+			ps.Strategy = p.ai.Name()
+		}
+		s.Players = append(s.Players, ps)
+	}
+
+	return s
+}
+
+// RestoreGame recreates a game from a snapshot. The AI players whose strategy cannot be created, like a bot
+// whose command is missing, play with the simple strategy instead, as told by Player.StrategyError.
+func RestoreGame(s Snapshot) (*Game, error) {
+	if s.Rules.DiceCount <= 0 || len(s.Rules.Faces) == 0 || len(s.Rules.Points) == 0 {
+		return nil, fmt.Errorf("%w: incomplete rules", ErrInvalidSnapshot)
+	}
+	if s.State != GameMenu && (s.Turn < 0 || s.Turn >= len(s.Players)) {
+		return nil, fmt.Errorf("%w: turn of player %d out of %d", ErrInvalidSnapshot, s.Turn+1, len(s.Players))
+	}
+	if len(s.Picked)+len(s.Roll) > s.Rules.DiceCount {
+		return nil, fmt.Errorf("%w: more dice than the rules allow", ErrInvalidSnapshot)
+	}
+
+	roller := NewSeededRoller(s.Seed)
+	roller.Skip(s.Throws, len(s.Rules.Faces))
+
+	g := NewGame(s.Rules, s.Seed)
+	g.State = s.State
+	g.turn = s.Turn
+	g.turnNr = s.TurnNr
+	g.phase = s.Phase
+	g.dice = NewDice(s.Rules, roller)
+	g.dice.roll = append([]Symbol(nil), s.Roll...)
+	g.dice.picked = append([]Symbol(nil), s.Picked...)
+	g.actions = append([]Action(nil), s.Actions...)
+
+	history, err := replayHistory(s)
+	if err != nil {
+		return nil, err
+	}
+	g.history = history
+	g.hints = slices.Clone(s.Hints)
+
+	g.board.tiles = map[int][]Tile{}
+	for _, t := range s.Board.FaceUp {
+		g.board.Put(t)
+	}
+	for _, t := range s.Board.FaceDown {
+		g.board.faceDown[t.Value] = append(g.board.faceDown[t.Value], t)
+	}
+
+	for _, ps := range s.Players {
+		p := NewPlayer(ps.Mode)
+		if ps.Mode == AI && ps.Strategy != "" {
+			ai, err := NewAIStrategy(ps.Strategy)
+			if err != nil {
+				ai = newStandInStrategy(ps.Strategy, err)
+			}
+			p.ai = ai
+		}
+
+		p.hints = ps.Hints
+		p.tiles = utils.NewStack[Tile]()
+		for _, t := range ps.Tiles {
+			p.tiles.Push(t)
+		}
+
+		g.players = append(g.players, p)
+	}
+
+	return g, nil
+}
+
+// replayHistory rebuilds the events of the snapshot game by replaying its decisions from the seed,
+// with the hints asked in between
+func replayHistory(s Snapshot) ([]Event, error) {
+	if s.State == GameMenu {
+		return nil, nil
+	}
+
+	g := NewGame(s.Rules, s.Seed)
+	players := make([]Player, len(s.Players))
+	for i, ps := range s.Players {
+		players[i] = NewPlayer(ps.Mode)
+	}
+	if err := g.StartWith(players); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+	}
+
+	for i, a := range s.Actions {
+		g.replayHints(s.Hints, i)
+		if err := g.Apply(a); err != nil {
+			return nil, fmt.Errorf("%w: action #%d %v: %w", ErrInvalidSnapshot, i+1, a, err)
+		}
+	}
+	g.replayHints(s.Hints, len(s.Actions))
+	if s.State == GameOver && g.State != GameOver {
+		g.Stop()
+	}
+
+	return g.history, nil
+}
+
+func (g *Game) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.Snapshot())
+}
+
+func (g *Game) UnmarshalJSON(data []byte) error {
+	var s Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	restored, err := RestoreGame(s)
+	if err != nil {
+		return err
+	}
+	*g = *restored
+
+	return nil
+}
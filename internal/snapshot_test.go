@@ -0,0 +1,122 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// playTurns plays the given number of turns, always picking the first symbol of the roll
+func playTurns(game *Game, turns int) {
+	for turn := 0; turn < turns && game.State == GameLoop; turn++ {
+		for game.Phase() == AwaitingRoll && !game.dice.IsDone() {
+			roll, _ := game.Roll()
+			if game.Phase() != AwaitingPick {
+				break
+			}
+			_ = game.Pick(roll[0])
+		}
+		game.NextTurn()
+	}
+}
+
+func TestGameJSONRoundTrip(t *testing.T) {
+	game := NewGame(ClassicRules, 99)
+	_ = game.Start(1, 2)
+	playTurns(game, 5)
+	if _, err := game.Hint(NewSimpleAIStrategy()); err != nil {
+		t.Fatalf("Hint() returned error: %v", err)
+	}
+	_, _ = game.Roll()
+
+	data, err := json.Marshal(game)
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+
+	restored := &Game{}
+	if err = json.Unmarshal(data, restored); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(restored.Snapshot(), game.Snapshot()) {
+		t.Errorf("Restored snapshot = %+v, want %+v", restored.Snapshot(), game.Snapshot())
+	}
+
+	if !reflect.DeepEqual(restored.History(), game.History()) {
+		t.Errorf("Restored history = %v, want %v", restored.History(), game.History())
+	}
+
+	if restored.String() != game.String() {
+		t.Errorf("Restored game = %s, want %s", restored.String(), game.String())
+	}
+
+	if !restored.players[1].IsAI() || restored.players[1].ai.Name() != "simple" {
+		t.Errorf("Restored AI player lost its strategy")
+	}
+
+	// Both games go on with the same throws
+	if game.Phase() == AwaitingPick {
+		_ = game.Pick(game.dice.roll[0])
+		_ = restored.Pick(restored.dice.roll[0])
+	}
+	playTurns(game, 5)
+	playTurns(restored, 5)
+
+	if !reflect.DeepEqual(restored.Snapshot(), game.Snapshot()) {
+		t.Errorf("Resumed game diverged: %+v, want %+v", restored.Snapshot(), game.Snapshot())
+	}
+}
+
+func TestRestoreGameInvalid(t *testing.T) {
+	valid := NewGame(DefaultRules, 1)
+	_ = valid.Start(2, 0)
+
+	tests := []struct {
+		name   string
+		modify func(*Snapshot)
+	}{
+		{"missing rules", func(s *Snapshot) { s.Rules = RuleSet{} }},
+		{"turn out of range", func(s *Snapshot) { s.Turn = 2 }},
+		{"too many dice", func(s *Snapshot) { s.Picked = make([]Symbol, 7) }},
+		{"actions not matching the game", func(s *Snapshot) { s.Actions = []Action{{Player: 1, Kind: StopAction}} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid.Snapshot()
+			tt.modify(&s)
+
+			if _, err := RestoreGame(s); !errors.Is(err, ErrInvalidSnapshot) {
+				t.Errorf("RestoreGame() error = %v, want %v", err, ErrInvalidSnapshot)
+			}
+		})
+	}
+}
+
+func TestRestoreGameWithUnavailableStrategy(t *testing.T) {
+	game := NewGame(DefaultRules, 1)
+	_ = game.StartWith([]Player{NewPlayer(Human), NewAIPlayer(NewSimpleAIStrategy())})
+	s := game.Snapshot()
+	s.Players[1].Strategy = "policy:/missing/policy.json"
+
+	restored, err := RestoreGame(s)
+	if err != nil {
+		t.Fatalf("RestoreGame() returned error: %v", err)
+	}
+
+	players := restored.Players()
+	if players[0].StrategyError() != nil || players[1].StrategyError() == nil {
+		t.Errorf("StrategyError() = %v and %v, want an error for P2 only", players[0].StrategyError(), players[1].StrategyError())
+	}
+	if name := restored.Snapshot().Players[1].Strategy; name != "policy:/missing/policy.json" {
+		t.Errorf("Restored strategy saved as %q, want the original name", name)
+	}
+
+	restored.NextTurn()
+	if _, reasoning := players[1].AiThink(restored); !strings.Contains(reasoning.Summary, "simple strategy decides") {
+		t.Errorf("AiThink() = %q, want the simple strategy to decide", reasoning.Summary)
+	}
+}
@@ -0,0 +1,240 @@
+package internal
+
+import (
+	"math"
+	"slices"
+)
+
+// turnSolver computes the exact expected value of the decisions of a turn. It searches over the
+// symbols picked so far and the dice left, using the face probabilities of the dice.
+type turnSolver struct {
+	symbols   []Symbol  // distinct symbols of the dice faces
+	probs     []float64 // probability of a die showing each symbol
+	points    []int     // points of each symbol
+	worm      int       // index of the worm symbol, -1 if the dice have none
+	diceCount int
+	stop      func(score int, hasWorm bool) float64 // value of ending the turn with the score
+	bust      float64                               // value of busting
+	memo      map[solverState]float64
+}
+
+type solverState struct {
+	mask  uint32 // symbols already picked
+	score int
+	dice  int // dice left to roll
+}
+
+// rollOutcome is a roll grouped by symbol: how many dice show every symbol not picked yet
+type rollOutcome struct {
+	counts []int
+	prob   float64
+}
+
+// newTurnSolver creates a solver for the dice of the rules, what they leave unset taking the default values
+func newTurnSolver(rules RuleSet, stop func(score int, hasWorm bool) float64, bust float64) *turnSolver {
+	rules = rules.WithDefaults()
+	t := &turnSolver{
+		worm:      -1,
+		diceCount: rules.DiceCount,
+		stop:      stop,
+		bust:      bust,
+		memo:      map[solverState]float64{},
+	}
+
+	for _, f := range rules.Faces {
+		i := slices.Index(t.symbols, f)
+		if i < 0 {
+			i = len(t.symbols)
+			t.symbols = append(t.symbols, f)
+			t.probs = append(t.probs, 0)
+			t.points = append(t.points, rules.Points[f])
+			if f == Worm {
+				t.worm = i
+			}
+		}
+		t.probs[i] += 1 / float64(len(rules.Faces))
+	}
+
+	return t
+}
+
+// state converts the picked dice into a search state
+func (t *turnSolver) state(picked []Symbol) (st solverState) {
+	st.dice = t.diceCount - len(picked)
+	for _, p := range picked {
+		if i := slices.Index(t.symbols, p); i >= 0 {
+			st.mask |= 1 << i
+			st.score += t.points[i]
+		}
+	}
+
+	return
+}
+
+func (t *turnSolver) hasWorm(st solverState) bool {
+	return t.worm >= 0 && st.mask&(1<<t.worm) != 0
+}
+
+// stopValue is the value of ending the turn with the picked dice
+func (t *turnSolver) stopValue(picked []Symbol) float64 {
+	st := t.state(picked)
+
+	return t.stop(st.score, t.hasWorm(st))
+}
+
+// rollValue is the expected value of rolling the dice left, then playing on optimally
+func (t *turnSolver) rollValue(picked []Symbol) float64 {
+	return t.roll(t.state(picked))
+}
+
+// bestValue is the expected value of the best decision, stopping or rolling, with the picked dice
+func (t *turnSolver) bestValue(picked []Symbol) float64 {
+	if len(picked) == 0 {
+		return t.roll(t.state(picked))
+	}
+
+	return t.value(t.state(picked))
+}
+
+// pickValue is the expected value of picking the symbol from the roll, then playing on optimally
+func (t *turnSolver) pickValue(picked, roll []Symbol, s Symbol) float64 {
+	i := slices.Index(t.symbols, s)
+	count := 0
+	for _, r := range roll {
+		if r == s {
+			count++
+		}
+	}
+
+	st := t.state(picked)
+	if i < 0 || count == 0 || st.mask&(1<<i) != 0 {
+		return math.Inf(-1)
+	}
+
+	return t.value(solverState{mask: st.mask | 1<<i, score: st.score + count*t.points[i], dice: st.dice - count})
+}
+
+// bustChance is the probability that rolling the dice left shows nothing that can be picked
+func (t *turnSolver) bustChance(picked []Symbol) (chance float64) {
+	st := t.state(picked)
+	for _, o := range t.outcomes(st) {
+		if t.pickable(o) == 0 {
+			chance += o.prob
+		}
+	}
+
+	return
+}
+
+// rollScore is the expected score after rolling the dice left once and picking the symbol worth the most
+// points, counting busts as 0
+func (t *turnSolver) rollScore(picked []Symbol) (score float64) {
+	st := t.state(picked)
+	for _, o := range t.outcomes(st) {
+		best := 0
+		for i, c := range o.counts {
+			if c > 0 && i < len(t.symbols) {
+				best = max(best, st.score+c*t.points[i])
+			}
+		}
+		score += o.prob * float64(best)
+	}
+
+	return
+}
+
+// value is the best of stopping and rolling, once at least one symbol was picked
+func (t *turnSolver) value(st solverState) float64 {
+	if v, known := t.memo[st]; known {
+		return v
+	}
+
+	v := t.stop(st.score, t.hasWorm(st))
+	if st.dice > 0 {
+		v = max(v, t.roll(st))
+	}
+	t.memo[st] = v
+
+	return v
+}
+
+func (t *turnSolver) roll(st solverState) (ev float64) {
+	if st.dice <= 0 {
+		return math.Inf(-1)
+	}
+
+	for _, o := range t.outcomes(st) {
+		best := math.Inf(-1)
+		for i, c := range o.counts {
+			if c == 0 || i >= len(t.symbols) {
+				continue
+			}
+			best = max(best, t.value(solverState{mask: st.mask | 1<<i, score: st.score + c*t.points[i], dice: st.dice - c}))
+		}
+		if math.IsInf(best, -1) {
+			best = t.bust
+		}
+
+		ev += o.prob * best
+	}
+
+	return
+}
+
+// pickable counts the symbols of the outcome that can still be picked
+func (t *turnSolver) pickable(o rollOutcome) (n int) {
+	for i, c := range o.counts {
+		if c > 0 && i < len(t.symbols) {
+			n++
+		}
+	}
+
+	return
+}
+
+// outcomes lists every distinct roll of the dice left with its probability. Symbols already picked are
+// grouped in an extra last count, as it does not matter which of them the dice show.
+func (t *turnSolver) outcomes(st solverState) (outcomes []rollOutcome) {
+	probs := make([]float64, len(t.symbols)+1)
+	for i, p := range t.probs {
+		if st.mask&(1<<i) != 0 {
+			probs[len(t.symbols)] += p
+		} else {
+			probs[i] = p
+		}
+	}
+
+	counts := make([]int, len(probs))
+	var walk func(i, left int, prob float64)
+	walk = func(i, left int, prob float64) {
+		if i == len(probs)-1 {
+			counts[i] = left
+			p := prob * math.Pow(probs[i], float64(left)) / factorial(left)
+			if p > 0 {
+				outcomes = append(outcomes, rollOutcome{counts: slices.Clone(counts), prob: p * factorial(st.dice)})
+			}
+
+			return
+		}
+
+		for c := 0; c <= left; c++ {
+			if c > 0 && probs[i] == 0 {
+				break
+			}
+			counts[i] = c
+			walk(i+1, left-c, prob*math.Pow(probs[i], float64(c))/factorial(c))
+		}
+	}
+	walk(0, st.dice, 1)
+
+	return
+}
+
+func factorial(n int) float64 {
+	f := 1.0
+	for i := 2; i <= n; i++ {
+		f *= float64(i)
+	}
+
+	return f
+}
@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"slices"
+)
+
+// Standing is the position of a player in the ranking of a game
+type Standing struct {
+	Rank        int    // 1 for the winner, tied players share the same rank
+	Player      int    // player number, starting from 1
+	Worms       int    // total worms on the tiles held
+	Tiles       []Tile // tiles held, top tile first
+	HighestTile int    // value of the highest tile held, used as tie-breaker
+	Tied        bool   // another player has the same worms and the same highest tile
+	TieBroken   bool   // another player has the same worms, but the highest tile decided the rank
+}
+
+// Standings ranks the players by worms. When worms are equal, the player holding the highest tile ranks first.
+func (g *Game) Standings() []Standing {
+	standings := make([]Standing, 0, len(g.players))
+	for i, p := range g.players {
+		sc := p.Scorecard()
+		s := Standing{Player: i + 1, Worms: sc.Worms, Tiles: sc.Tiles}
+		for _, t := range sc.Tiles {
+			s.HighestTile = max(s.HighestTile, t.Value)
+		}
+
+		standings = append(standings, s)
+	}
+
+	slices.SortStableFunc(standings, func(a, b Standing) int {
+		if a.Worms != b.Worms {
+			return b.Worms - a.Worms
+		}
+
+		return b.HighestTile - a.HighestTile
+	})
+
+	for i := range standings {
+		standings[i].Rank = i + 1
+		if i > 0 && standings[i].Worms == standings[i-1].Worms {
+			if standings[i].HighestTile == standings[i-1].HighestTile {
+				standings[i].Rank = standings[i-1].Rank
+				standings[i].Tied, standings[i-1].Tied = true, true
+			} else {
+				standings[i].TieBroken, standings[i-1].TieBroken = true, true
+			}
+		}
+	}
+
+	return standings
+}
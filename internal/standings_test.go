@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"testing"
+)
+
+func TestGameStandings(t *testing.T) {
+	tests := []struct {
+		name       string
+		tiles      [][]Tile
+		wantOrder  []int
+		wantRanks  []int
+		wantTied   bool
+		wantBroken bool
+	}{
+		{
+			name: "clear winner",
+			tiles: [][]Tile{
+				{{Value: 4, Worms: 1}},
+				{{Value: 8, Worms: 3}, {Value: 5, Worms: 1}},
+			},
+			wantOrder: []int{2, 1},
+			wantRanks: []int{1, 2},
+		},
+		{
+			name: "equal worms - highest tile wins",
+			tiles: [][]Tile{
+				{{Value: 6, Worms: 2}, {Value: 7, Worms: 2}},
+				{{Value: 9, Worms: 4}},
+			},
+			wantOrder:  []int{2, 1},
+			wantRanks:  []int{1, 2},
+			wantBroken: true,
+		},
+		{
+			name: "equal worms and highest tile - tie",
+			tiles: [][]Tile{
+				{{Value: 9, Worms: 4}},
+				{{Value: 9, Worms: 4}},
+			},
+			wantOrder: []int{1, 2},
+			wantRanks: []int{1, 1},
+			wantTied:  true,
+		},
+		{
+			name: "tie with an earlier non-leader is not a tie for the win",
+			tiles: [][]Tile{
+				{{Value: 4, Worms: 1}},
+				{{Value: 8, Worms: 3}},
+				{{Value: 5, Worms: 1}},
+			},
+			wantOrder:  []int{2, 3, 1},
+			wantRanks:  []int{1, 2, 3},
+			wantBroken: false,
+		},
+		{
+			name:      "nobody has tiles",
+			tiles:     [][]Tile{{}, {}},
+			wantOrder: []int{1, 2},
+			wantRanks: []int{1, 1},
+			wantTied:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := NewGame(DefaultRules, 1)
+			_ = game.Start(len(tt.tiles), 0)
+			for i, tiles := range tt.tiles {
+				for _, tile := range tiles {
+					game.players[i].tiles.Push(tile)
+				}
+			}
+
+			standings := game.Standings()
+
+			for i, s := range standings {
+				if s.Player != tt.wantOrder[i] {
+					t.Errorf("Standings()[%d].Player = %d, want %d", i, s.Player, tt.wantOrder[i])
+				}
+				if s.Rank != tt.wantRanks[i] {
+					t.Errorf("Standings()[%d].Rank = %d, want %d", i, s.Rank, tt.wantRanks[i])
+				}
+			}
+
+			if standings[0].Tied != tt.wantTied {
+				t.Errorf("Standings()[0].Tied = %v, want %v", standings[0].Tied, tt.wantTied)
+			}
+			if standings[0].TieBroken != tt.wantBroken {
+				t.Errorf("Standings()[0].TieBroken = %v, want %v", standings[0].TieBroken, tt.wantBroken)
+			}
+
+			// Standings must not change the players stacks
+			again := game.Standings()
+			if again[0].Worms != standings[0].Worms || len(again[0].Tiles) != len(standings[0].Tiles) {
+				t.Errorf("Standings() is not repeatable: %v vs %v", again[0], standings[0])
+			}
+		})
+	}
+}
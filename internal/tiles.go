@@ -8,39 +8,31 @@ import (
 
 var ErrCannotTakeFromBoard = errors.New("cannot take a tile from the board")
 
-var defaultTiles = []Tile{
-	{Value: 4, Worms: 1},
-	{Value: 4, Worms: 1},
-	{Value: 5, Worms: 1},
-	{Value: 5, Worms: 1},
-	{Value: 6, Worms: 2},
-	{Value: 6, Worms: 2},
-	{Value: 7, Worms: 2},
-	{Value: 7, Worms: 2},
-	{Value: 8, Worms: 3},
-	{Value: 8, Worms: 3},
-	{Value: 9, Worms: 4},
-	{Value: 9, Worms: 4},
-}
-
 type Tile struct {
-	Value int
-	Worms int
+	Value int `json:"value"`
+	Worms int `json:"worms"`
 }
 
+// Board holds the tiles still in play. Face down tiles stay on the board, but can no longer be taken.
 type Board struct {
-	tiles map[int][]Tile
-	min   int
-	max   int
+	tiles    map[int][]Tile
+	faceDown map[int][]Tile
+	min      int
+	max      int
+}
+
+func NewDefaultBoard() *Board {
+	return NewBoard(DefaultRules.Tiles)
 }
 
-func NewDefaultBoard() (board *Board) {
+func NewBoard(tiles []Tile) (board *Board) {
 	board = &Board{
-		min:   1_000_000,
-		max:   0,
-		tiles: map[int][]Tile{},
+		min:      1_000_000,
+		max:      0,
+		tiles:    map[int][]Tile{},
+		faceDown: map[int][]Tile{},
 	}
-	for _, t := range defaultTiles {
+	for _, t := range tiles {
 		board.tiles[t.Value] = append(board.tiles[t.Value], t)
 		if t.Value > board.max {
 			board.max = t.Value
@@ -70,7 +62,50 @@ func (b *Board) Take(val int) (t Tile, err error) {
 	return
 }
 
-// HasTile checks if a tile with the given value is available
+// Put returns a tile to the board, e.g. when a player busts and loses their top tile.
+func (b *Board) Put(t Tile) {
+	b.tiles[t.Value] = append(b.tiles[t.Value], t)
+	if t.Value > b.max {
+		b.max = t.Value
+	}
+	if t.Value < b.min {
+		b.min = t.Value
+	}
+}
+
+// Flip turns a face up tile with the given value face down.
+func (b *Board) Flip(val int) (t Tile, err error) {
+	t, err = b.Take(val)
+	if err != nil {
+		return
+	}
+
+	b.faceDown[val] = append(b.faceDown[val], t)
+
+	return
+}
+
+// FaceDownCount returns how many tiles on the board have been turned face down.
+func (b *Board) FaceDownCount() (count int) {
+	for _, tiles := range b.faceDown {
+		count += len(tiles)
+	}
+
+	return
+}
+
+// Highest returns the highest value face up tile on the board, if any.
+func (b *Board) Highest() (t Tile, exists bool) {
+	for i := b.max; i >= b.min; i-- {
+		if len(b.tiles[i]) > 0 {
+			return b.tiles[i][len(b.tiles[i])-1], true
+		}
+	}
+
+	return
+}
+
+// HasTile checks if a face up tile with the given value is available
 func (b *Board) HasTile(value int) bool {
 	if value < b.min || value > b.max {
 		return false
@@ -79,6 +114,7 @@ func (b *Board) HasTile(value int) bool {
 	return len(b.tiles[value]) > 0
 }
 
+// IsEmpty checks if no face up tiles are left, face down tiles do not count
 func (b *Board) IsEmpty() bool {
 	return len(b.tiles) == 0
 }
@@ -89,6 +125,9 @@ func (b *Board) String() string {
 		for _, t := range b.tiles[i] {
 			sb.WriteString(fmt.Sprintf("[%d] ", t.Value))
 		}
+		for range b.faceDown[i] {
+			sb.WriteString("[##] ")
+		}
 	}
 
 	return sb.String()
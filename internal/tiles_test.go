@@ -2,6 +2,7 @@ package internal
 
 import (
 	"errors"
+	"strings"
 	"testing"
 )
 
@@ -75,3 +76,78 @@ func TestBoardIsEmpty(t *testing.T) {
 		t.Errorf("Board should be empty after taking all tiles")
 	}
 }
+
+func TestBoardPutAndHighest(t *testing.T) {
+	board := NewDefaultBoard()
+
+	highest, exists := board.Highest()
+	if !exists || highest.Value != board.max {
+		t.Errorf("Highest() = %d, %v, want %d, true", highest.Value, exists, board.max)
+	}
+
+	tile, _ := board.Take(4)
+	_, _ = board.Take(4)
+	if board.HasTile(4) {
+		t.Fatalf("Board should not have a 4 after taking both")
+	}
+
+	board.Put(tile)
+	if !board.HasTile(4) {
+		t.Errorf("Board should have a 4 again after Put()")
+	}
+
+	for val := range board.tiles {
+		for len(board.tiles[val]) > 0 {
+			_, _ = board.Take(val)
+		}
+	}
+
+	if _, exists = board.Highest(); exists {
+		t.Errorf("Highest() on an empty board should not exist")
+	}
+}
+
+func TestBoardFlip(t *testing.T) {
+	board := NewDefaultBoard()
+
+	if _, err := board.Flip(9); err != nil {
+		t.Fatalf("Flip(9) returned error: %v", err)
+	}
+	if _, err := board.Flip(9); err != nil {
+		t.Fatalf("Flip(9) returned error: %v", err)
+	}
+
+	if board.HasTile(9) {
+		t.Errorf("Face down tiles should not be available")
+	}
+
+	if _, err := board.Take(9); !errors.Is(err, ErrCannotTakeFromBoard) {
+		t.Errorf("Take(9) of face down tiles should return ErrCannotTakeFromBoard, got %v", err)
+	}
+
+	if _, err := board.Flip(9); !errors.Is(err, ErrCannotTakeFromBoard) {
+		t.Errorf("Flip(9) of face down tiles should return ErrCannotTakeFromBoard, got %v", err)
+	}
+
+	if highest, _ := board.Highest(); highest.Value != 8 {
+		t.Errorf("Highest() = %d, want 8", highest.Value)
+	}
+
+	if got := board.FaceDownCount(); got != 2 {
+		t.Errorf("FaceDownCount() = %d, want 2", got)
+	}
+
+	if s := board.String(); !strings.HasSuffix(s, "[8] [8] [##] [##] ") {
+		t.Errorf("String() = %q, should draw face down tiles last", s)
+	}
+
+	for val := range board.tiles {
+		for len(board.tiles[val]) > 0 {
+			_, _ = board.Flip(val)
+		}
+	}
+
+	if !board.IsEmpty() {
+		t.Errorf("Board with only face down tiles should be empty")
+	}
+}
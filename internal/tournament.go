@@ -0,0 +1,201 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var ErrTournamentSetup = errors.New("invalid tournament setup")
+
+// Tournament plays round-robin matches between AI strategies. Every pair of strategies plays Games games,
+// each deal being played twice with the seats swapped, so that neither strategy benefits from starting.
+// The deals derive from the seed and are the same for every pair.
+type Tournament struct {
+	Rules      RuleSet
+	Strategies []string
+	Games      int // per pair of strategies
+	Seed       int64
+}
+
+// StrategyStats sums up how a strategy fared over all its games
+type StrategyStats struct {
+	Strategy string  `json:"strategy"`
+	Games    int     `json:"games"`
+	Wins     int     `json:"wins"`
+	Ties     int     `json:"ties"` // games shared with other winners
+	Worms    int     `json:"worms"`
+	worms2   float64 // sum of squared worms, for the confidence interval
+	Turns    int     `json:"turns"`
+	Busts    int     `json:"busts"`
+}
+
+// MatchStats sums up the games between two strategies
+type MatchStats struct {
+	A, B  string
+	WinsA int
+	WinsB int
+	Ties  int
+}
+
+type TournamentResult struct {
+	Strategies []StrategyStats // in the order of the tournament strategies
+	Matches    []MatchStats
+}
+
+// Run plays the whole tournament. The progress function, if not nil, is called after every game.
+func (t Tournament) Run(progress func(played, total int)) (result TournamentResult, err error) {
+	if len(t.Strategies) < 2 || t.Games <= 0 {
+		return result, fmt.Errorf("%w: at least two strategies and one game are needed", ErrTournamentSetup)
+	}
+	for _, name := range t.Strategies {
+		if _, err = NewAIStrategy(name); err != nil {
+			return result, fmt.Errorf("%w: %w", ErrTournamentSetup, err)
+		}
+		result.Strategies = append(result.Strategies, StrategyStats{Strategy: name})
+	}
+
+	total := len(t.Strategies) * (len(t.Strategies) - 1) / 2 * t.Games
+	played := 0
+	for a := range t.Strategies {
+		for b := a + 1; b < len(t.Strategies); b++ {
+			match := MatchStats{A: t.Strategies[a], B: t.Strategies[b]}
+
+			for i := 0; i < t.Games; i++ {
+				seats := []int{a, b}
+				if i%2 == 1 {
+					seats = []int{b, a}
+				}
+
+				game, err := t.play(t.Seed+int64(i/2), seats)
+				if err != nil {
+					return result, err
+				}
+
+				for seat, s := range seats {
+					result.Strategies[s].add(game, seat)
+				}
+				switch {
+				case game.Winner == 0:
+					match.Ties++
+				case seats[game.Winner-1] == a:
+					match.WinsA++
+				default:
+					match.WinsB++
+				}
+
+				played++
+				if progress != nil {
+					progress(played, total)
+				}
+			}
+
+			result.Matches = append(result.Matches, match)
+		}
+	}
+
+	return
+}
+
+// play plays one game with the given strategies by seat
+func (t Tournament) play(seed int64, seats []int) (GameStats, error) {
+	var strategies []AIStrategy
+	for _, s := range seats {
+		ai, err := NewAIStrategy(t.Strategies[s])
+		if err != nil {
+			return GameStats{}, err
+		}
+		strategies = append(strategies, ai)
+	}
+
+	return playAIGame(t.Rules, seed, strategies)
+}
+
+// playAIGame plays a game between the strategies, in seat order, and sums it up
+func playAIGame(rules RuleSet, seed int64, strategies []AIStrategy) (GameStats, error) {
+	var players []Player
+	for _, ai := range strategies {
+		players = append(players, NewAIPlayer(ai))
+	}
+
+	game := NewGame(rules, seed)
+	if err := game.StartWith(players); err != nil {
+		return GameStats{}, fmt.Errorf("%w: %w", ErrTournamentSetup, err)
+	}
+	defer game.Close()
+
+	if err := game.AutoPlay(); err != nil {
+		return GameStats{}, err
+	}
+
+	return game.Stats()
+}
+
+// add counts a game played from the seat
+func (s *StrategyStats) add(game GameStats, seat int) {
+	p := game.Players[seat]
+	s.Games++
+	s.Worms += p.Worms
+	s.worms2 += float64(p.Worms * p.Worms)
+	s.Turns, s.Busts = s.Turns+p.Turns, s.Busts+p.Busts
+
+	switch {
+	case p.Rank == 1 && game.Winner == 0:
+		s.Ties++
+	case p.Rank == 1:
+		s.Wins++
+	}
+}
+
+// WinRate is the share of games won, a tie counting as half a win
+func (s StrategyStats) WinRate() float64 {
+	if s.Games == 0 {
+		return 0
+	}
+
+	return (float64(s.Wins) + float64(s.Ties)/2) / float64(s.Games)
+}
+
+// WinRateInterval is the 95% Wilson confidence interval of the win rate
+func (s StrategyStats) WinRateInterval() (low, high float64) {
+	if s.Games == 0 {
+		return 0, 1
+	}
+
+	const z = 1.96
+	n, p := float64(s.Games), s.WinRate()
+	center := (p + z*z/(2*n)) / (1 + z*z/n)
+	margin := z / (1 + z*z/n) * math.Sqrt(p*(1-p)/n+z*z/(4*n*n))
+
+	return max(0, center-margin), min(1, center+margin)
+}
+
+// AverageWorms is the average number of worms at the end of a game
+func (s StrategyStats) AverageWorms() float64 {
+	if s.Games == 0 {
+		return 0
+	}
+
+	return float64(s.Worms) / float64(s.Games)
+}
+
+// AverageWormsMargin is the half width of the 95% confidence interval of the average worms
+func (s StrategyStats) AverageWormsMargin() float64 {
+	if s.Games < 2 {
+		return math.Inf(1)
+	}
+
+	n, mean := float64(s.Games), s.AverageWorms()
+	variance := (s.worms2 - n*mean*mean) / (n - 1)
+
+	return 1.96 * math.Sqrt(max(0, variance)/n)
+}
+
+// BustRate is the share of turns that ended in a bust
+func (s StrategyStats) BustRate() float64 {
+	if s.Turns == 0 {
+		return 0
+	}
+
+	return float64(s.Busts) / float64(s.Turns)
+}
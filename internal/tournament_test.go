@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestGameAutoPlay(t *testing.T) {
+	game := NewGame(DefaultRules, 4)
+	_ = game.Start(1, 1)
+	if err := game.AutoPlay(); !errors.Is(err, ErrNotAllAI) {
+		t.Errorf("AutoPlay() with a human error = %v, want %v", err, ErrNotAllAI)
+	}
+
+	game = NewGame(ClassicRules, 4)
+	_ = game.Start(0, 4)
+	if err := game.AutoPlay(); err != nil {
+		t.Fatalf("AutoPlay() returned error: %v", err)
+	}
+	if game.State != GameOver {
+		t.Errorf("AutoPlay() left the game in state %v", game.State)
+	}
+}
+
+func TestTournamentRun(t *testing.T) {
+	tournament := Tournament{Rules: DefaultRules, Strategies: []string{"easy", "simple", "expectimax"}, Games: 6, Seed: 3}
+
+	var calls int
+	result, err := tournament.Run(func(played, total int) {
+		calls++
+		if played != calls || total != 18 {
+			t.Errorf("progress(%d, %d) at call %d, want (%d, 18)", played, total, calls, calls)
+		}
+	})
+	if err != nil {
+		t.Fatalf("Run() returned error: %v", err)
+	}
+
+	if len(result.Matches) != 3 {
+		t.Errorf("Run() played %d matches, want 3", len(result.Matches))
+	}
+	for _, m := range result.Matches {
+		if m.WinsA+m.WinsB+m.Ties != tournament.Games {
+			t.Errorf("Match %s vs %s = %d - %d, %d ties, want %d games", m.A, m.B, m.WinsA, m.WinsB, m.Ties, tournament.Games)
+		}
+	}
+	for _, s := range result.Strategies {
+		if s.Games != 2*tournament.Games || s.Turns == 0 {
+			t.Errorf("Strategy %s played %d games and %d turns, want %d games", s.Strategy, s.Games, s.Turns, 2*tournament.Games)
+		}
+	}
+
+	again, _ := tournament.Run(nil)
+	if !reflect.DeepEqual(again, result) {
+		t.Errorf("Run() with the same seed = %+v, want %+v", again, result)
+	}
+
+	invalid := Tournament{Rules: DefaultRules, Strategies: []string{"simple", "cheater"}, Games: 1}
+	if _, err = invalid.Run(nil); !errors.Is(err, ErrTournamentSetup) {
+		t.Errorf("Run() with an unknown strategy error = %v, want %v", err, ErrTournamentSetup)
+	}
+}
+
+func TestStrategyStats(t *testing.T) {
+	s := StrategyStats{Games: 100, Wins: 40, Ties: 20, Worms: 500, worms2: 2600, Turns: 1000, Busts: 250}
+
+	if got := s.WinRate(); got != 0.5 {
+		t.Errorf("WinRate() = %f, want 0.5", got)
+	}
+	if low, high := s.WinRateInterval(); math.Abs(low-0.4038) > 1e-3 || math.Abs(high-0.5962) > 1e-3 {
+		t.Errorf("WinRateInterval() = %f, %f, want about 0.404, 0.596", low, high)
+	}
+	if got := s.AverageWorms(); got != 5 {
+		t.Errorf("AverageWorms() = %f, want 5", got)
+	}
+	if got := s.AverageWormsMargin(); math.Abs(got-1.96*math.Sqrt(100.0/99/100)) > 1e-9 {
+		t.Errorf("AverageWormsMargin() = %f", got)
+	}
+	if got := s.BustRate(); got != 0.25 {
+		t.Errorf("BustRate() = %f, want 0.25", got)
+	}
+}
@@ -0,0 +1,150 @@
+package internal
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+)
+
+// Trainer learns a policy by Q-learning in self-play. Every seat plays by the policy being learned, exploring
+// a random decision now and then. The value of stopping is the worm swing of the tile it takes, known exactly;
+// the value of rolling is learned from what the next decision, or a bust, turned out to be worth.
+type Trainer struct {
+	Rules   RuleSet
+	Players int
+	Games   int
+	Alpha   float64 // smallest learning rate, the rate starts at 1 and decreases with the visits of a state
+	Epsilon float64 // chance of exploring a random decision
+	Seed    int64
+}
+
+// Run trains a new policy. The progress function, if not nil, is called after every game.
+func (t Trainer) Run(progress func(played, total int)) (*Policy, error) {
+	if t.Games <= 0 {
+		return nil, fmt.Errorf("%w: at least one game is needed", ErrTournamentSetup)
+	}
+	if t.Alpha <= 0 || t.Alpha > 1 || t.Epsilon < 0 || t.Epsilon > 1 {
+		return nil, fmt.Errorf("%w: the learning rate must be in (0, 1] and the exploration rate in [0, 1]", ErrTournamentSetup)
+	}
+
+	policy := NewPolicy(t.Rules)
+	rnd := rand.New(rand.NewSource(t.Seed))
+	for i := 0; i < t.Games; i++ {
+		players := make([]Player, t.Players)
+		for p := range players {
+			players[p] = NewPlayer(AI)
+		}
+
+		game := NewGame(t.Rules, t.Seed+int64(i))
+		if err := game.StartWith(players); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrTournamentSetup, err)
+		}
+
+		for turns := 0; game.State == GameLoop; turns++ {
+			if turns == maxAutoPlayTurns {
+				return nil, fmt.Errorf("game %d stopped after %d turns", i+1, turns)
+			}
+			if err := t.playTurn(game, policy, rnd); err != nil {
+				return nil, fmt.Errorf("game %d at turn %d: %w", i+1, game.turnNr, err)
+			}
+		}
+
+		if progress != nil {
+			progress(i+1, t.Games)
+		}
+	}
+
+	return policy, nil
+}
+
+// playTurn plays the turn of the current player by the policy, learning from every decision
+func (t Trainer) playTurn(game *Game, policy *Policy, rnd *rand.Rand) error {
+	view := game.View()
+	rolled := "" // state in which the last roll was decided, none for the first roll
+	for {
+		if _, err := game.Roll(); err != nil {
+			return err
+		}
+		if game.phase == Busted {
+			t.learnRoll(policy, rolled, view.bustSwing())
+
+			break
+		}
+
+		view = game.View()
+		symbol := t.choosePick(policy, view, rnd)
+		picked := afterPick(view, symbol)
+		value, _ := policy.value(view, picked)
+		t.learnRoll(policy, rolled, value)
+		if err := game.Pick(symbol); err != nil {
+			return err
+		}
+
+		view = game.View()
+		if view.DiceLeft() == 0 {
+			if err := game.StopTurn(); err != nil {
+				return err
+			}
+
+			break
+		}
+
+		rolled = policyState(view, view.Picked)
+		values := policy.Values[rolled]
+		score, hasWorm := view.PickedScore()
+		values.Stop = view.stopSwing(score, hasWorm)
+		values.StopVisits++
+		policy.Values[rolled] = values
+
+		// Rolling is preferred until it is tried, so that every state gets explored
+		roll := values.RollVisits == 0 || values.Roll > values.Stop
+		if rnd.Float64() < t.Epsilon {
+			roll = rnd.Intn(2) == 0
+		}
+		if !roll {
+			if err := game.StopTurn(); err != nil {
+				return err
+			}
+
+			break
+		}
+	}
+	game.NextTurn()
+
+	return nil
+}
+
+// choosePick picks the symbol leading to the best known state, or a random one when exploring
+func (t Trainer) choosePick(policy *Policy, view GameView, rnd *rand.Rand) Symbol {
+	var pickable []Symbol
+	for _, r := range view.Roll {
+		if view.CanPick(r) && !slices.Contains(pickable, r) {
+			pickable = append(pickable, r)
+		}
+	}
+
+	if rnd.Float64() < t.Epsilon {
+		return pickable[rnd.Intn(len(pickable))]
+	}
+
+	best, bestValue := pickable[0], 0.0
+	for i, s := range pickable {
+		if v, _ := policy.value(view, afterPick(view, s)); i == 0 || v > bestValue {
+			best, bestValue = s, v
+		}
+	}
+
+	return best
+}
+
+// learnRoll moves the value of rolling in the state towards the target
+func (t Trainer) learnRoll(policy *Policy, state string, target float64) {
+	if state == "" {
+		return
+	}
+
+	values := policy.Values[state]
+	values.RollVisits++
+	values.Roll += max(1/float64(values.RollVisits), t.Alpha) * (target - values.Roll)
+	policy.Values[state] = values
+}
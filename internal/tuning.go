@@ -0,0 +1,81 @@
+package internal
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Tuner searches parameters of the simple strategy that beat a baseline strategy in self-play. It starts from
+// the default parameters of the rules, then tries candidates that are either random or small changes of the best ones so far.
+// Every candidate plays the same deals, each of them twice with the seats swapped.
+type Tuner struct {
+	Rules      RuleSet
+	Baseline   string // name of the strategy to beat
+	Games      int    // per candidate
+	Candidates int
+	Seed       int64
+}
+
+// Run tunes the parameters and returns the best ones with their win rate against the baseline.
+// The improved function, if not nil, is called whenever better parameters are found.
+func (t Tuner) Run(improved func(candidate int, params SimpleParams, winRate float64)) (best SimpleParams, bestRate float64, err error) {
+	if t.Games <= 0 || t.Candidates <= 0 {
+		return best, 0, fmt.Errorf("%w: at least one game and one candidate are needed", ErrTournamentSetup)
+	}
+	if _, err = NewAIStrategy(t.Baseline); err != nil {
+		return best, 0, fmt.Errorf("%w: %w", ErrTournamentSetup, err)
+	}
+
+	rnd := rand.New(rand.NewSource(t.Seed))
+	best, bestRate = DefaultSimpleParamsFor(t.Rules), -1
+
+	for c := 0; c < t.Candidates; c++ {
+		candidate := best
+		switch {
+		case c == 0:
+		case rnd.Intn(3) == 0:
+			candidate = randomSimpleParams(rnd, t.Rules)
+		default:
+			candidate = best.mutate(rnd, t.Rules)
+		}
+
+		rate, err := t.evaluate(candidate)
+		if err != nil {
+			return best, bestRate, err
+		}
+
+		if rate > bestRate {
+			best, bestRate = candidate, rate
+			if improved != nil {
+				improved(c+1, best, bestRate)
+			}
+		}
+	}
+
+	return
+}
+
+// evaluate plays the candidate against the baseline, and returns its win rate counting ties as half a win
+func (t Tuner) evaluate(params SimpleParams) (float64, error) {
+	var stats StrategyStats
+	for i := 0; i < t.Games; i++ {
+		baseline, err := NewAIStrategy(t.Baseline)
+		if err != nil {
+			return 0, err
+		}
+
+		strategies, seat := []AIStrategy{NewSimpleAIStrategyWith(params), baseline}, 0
+		if i%2 == 1 {
+			strategies, seat = []AIStrategy{baseline, strategies[0]}, 1
+		}
+
+		game, err := playAIGame(t.Rules, t.Seed+int64(i/2), strategies)
+		if err != nil {
+			return 0, err
+		}
+
+		stats.add(game, seat)
+	}
+
+	return stats.WinRate(), nil
+}
@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrIllegalAction = errors.New("action not allowed now")
+
+// TurnPhase tells which actions the current player can take
+type TurnPhase int
+
+const (
+	AwaitingRoll TurnPhase = iota // the player can roll the remaining dice, or stop if something was picked
+	AwaitingPick                  // the player must pick a symbol from the roll
+	Busted                        // the turn failed and the penalty was applied
+	Resolved                      // the turn ended and its tile, if any, was taken
+)
+
+func (p TurnPhase) String() string {
+	switch p {
+	case AwaitingRoll:
+		return "awaiting roll"
+	case AwaitingPick:
+		return "awaiting pick"
+	case Busted:
+		return "busted"
+	case Resolved:
+		return "resolved"
+	default:
+		return "unknown"
+	}
+}
+
+// IsOver checks if the turn has ended and the game can move to the next player
+func (p TurnPhase) IsOver() bool {
+	return p == Busted || p == Resolved
+}
+
+// PhaseError is returned when an action is not allowed in the current turn phase
+type PhaseError struct {
+	Action string
+	Phase  TurnPhase
+}
+
+func (e *PhaseError) Error() string {
+	return fmt.Sprintf("cannot %s while %s: %s", e.Action, e.Phase, ErrIllegalAction)
+}
+
+func (e *PhaseError) Unwrap() error {
+	return ErrIllegalAction
+}
+
+func (g *Game) Phase() TurnPhase {
+	return g.phase
+}
+
+// Roll throws the dice not picked yet. If nothing from the roll can be picked, the player busts.
+func (g *Game) Roll() ([]Symbol, error) {
+	if g.State != GameLoop {
+		return nil, ErrGameOver
+	}
+	if g.phase != AwaitingRoll {
+		return nil, &PhaseError{Action: "roll", Phase: g.phase}
+	}
+	if g.dice.IsDone() {
+		return nil, ErrFullyPicked
+	}
+
+	roll := g.dice.throw()
+	g.phase = AwaitingPick
+	g.act(Action{Kind: RollAction})
+	g.record(RollEvent{Turn: g.turnNr, Player: g.turn + 1, Roll: slices.Clone(roll)})
+
+	if g.dice.IsBusted() {
+		g.resolveCurrentTurn()
+	}
+
+	return roll, nil
+}
+
+// Pick sets aside all the dice of the roll showing the symbol
+func (g *Game) Pick(s Symbol) error {
+	if g.State != GameLoop {
+		return ErrGameOver
+	}
+	if g.phase != AwaitingPick {
+		return &PhaseError{Action: "pick", Phase: g.phase}
+	}
+
+	picked := len(g.dice.picked)
+	if err := g.dice.pick(s); err != nil {
+		return err
+	}
+	g.phase = AwaitingRoll
+	g.act(Action{Kind: PickAction, Symbol: s})
+	g.record(PickEvent{Turn: g.turnNr, Player: g.turn + 1, Symbol: s, Count: len(g.dice.picked) - picked})
+
+	return nil
+}
+
+// StopTurn ends the turn with the picked dice: the player takes a tile, or busts if none can be taken
+func (g *Game) StopTurn() error {
+	if g.State != GameLoop {
+		return ErrGameOver
+	}
+	if g.phase != AwaitingRoll {
+		return &PhaseError{Action: "stop", Phase: g.phase}
+	}
+	if len(g.dice.picked) == 0 {
+		return ErrNoRollYet
+	}
+
+	g.act(Action{Kind: StopAction})
+	g.resolveCurrentTurn()
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+)
+
+// newScriptedGame starts a two players game whose dice land on the given face indexes
+func newScriptedGame(faces ...int) *Game {
+	game := NewGame(DefaultRules, 1)
+	_ = game.Start(2, 0)
+	game.dice = NewDice(DefaultRules, &scriptedRoller{faces: faces})
+
+	return game
+}
+
+func TestGameTurnActions(t *testing.T) {
+	// Faces: 0,1 Worm - 2 Bread - 3 Cucumber - 4 Ketchup - 5 Cheese
+	game := newScriptedGame(0, 0, 2, 2, 3, 5, 2, 3, 4, 5)
+
+	if game.Phase() != AwaitingRoll {
+		t.Fatalf("Phase() at turn start = %v, want %v", game.Phase(), AwaitingRoll)
+	}
+
+	if err := game.Pick(Worm); !errors.Is(err, ErrIllegalAction) {
+		t.Errorf("Pick() before rolling error = %v, want %v", err, ErrIllegalAction)
+	}
+
+	if err := game.StopTurn(); !errors.Is(err, ErrNoRollYet) {
+		t.Errorf("StopTurn() before rolling error = %v, want %v", err, ErrNoRollYet)
+	}
+
+	if _, err := game.Roll(); err != nil {
+		t.Fatalf("Roll() returned error: %v", err)
+	}
+
+	if _, err := game.Roll(); !errors.Is(err, ErrIllegalAction) {
+		t.Errorf("Roll() twice error = %v, want %v", err, ErrIllegalAction)
+	}
+
+	var phaseErr *PhaseError
+	if err := game.StopTurn(); !errors.As(err, &phaseErr) || phaseErr.Phase != AwaitingPick {
+		t.Errorf("StopTurn() before picking error = %v, want a PhaseError while %v", err, AwaitingPick)
+	}
+
+	if err := game.Pick(Ketchup); !errors.Is(err, ErrPickMustBeInRoll) {
+		t.Errorf("Pick() of a symbol not rolled error = %v, want %v", err, ErrPickMustBeInRoll)
+	}
+
+	if err := game.Pick(Worm); err != nil {
+		t.Fatalf("Pick(Worm) returned error: %v", err)
+	}
+
+	if _, err := game.Roll(); err != nil {
+		t.Fatalf("Second Roll() returned error: %v", err)
+	}
+
+	if err := game.Pick(Bread); err != nil {
+		t.Fatalf("Pick(Bread) returned error: %v", err)
+	}
+
+	if err := game.StopTurn(); err != nil {
+		t.Fatalf("StopTurn() returned error: %v", err)
+	}
+
+	if game.Phase() != Resolved {
+		t.Errorf("Phase() after stopping = %v, want %v", game.Phase(), Resolved)
+	}
+
+	if top, _ := game.players[0].tiles.Top(); top.Value != 4 {
+		t.Errorf("Player took tile %d, want 4", top.Value)
+	}
+
+	if _, err := game.Roll(); !errors.Is(err, ErrIllegalAction) {
+		t.Errorf("Roll() after the turn ended error = %v, want %v", err, ErrIllegalAction)
+	}
+
+	game.NextTurn()
+
+	if game.Phase() != AwaitingRoll || game.turn != 1 {
+		t.Errorf("After NextTurn() phase = %v, turn = %d, want %v and 1", game.Phase(), game.turn, AwaitingRoll)
+	}
+}
+
+func TestGameTurnAllDicePicked(t *testing.T) {
+	game := newScriptedGame(0, 0, 0, 2, 2, 2, 2, 2, 2)
+
+	_, _ = game.Roll()
+	_ = game.Pick(Worm)
+	_, _ = game.Roll()
+	_ = game.Pick(Bread)
+
+	if _, err := game.Roll(); !errors.Is(err, ErrFullyPicked) {
+		t.Fatalf("Roll() with all dice picked error = %v, want %v", err, ErrFullyPicked)
+	}
+
+	if err := game.StopTurn(); err != nil {
+		t.Fatalf("StopTurn() with all dice picked returned error: %v", err)
+	}
+
+	if top, _ := game.players[0].tiles.Top(); top.Value != 9 {
+		t.Errorf("Player took tile %d, want 9", top.Value)
+	}
+}
+
+func TestGameTurnBust(t *testing.T) {
+	// Bread x3, Cucumber, Ketchup, Cheese - then Worm, Cucumber, Cheese - then Worm, Bread
+	game := newScriptedGame(2, 2, 2, 3, 4, 5, 0, 3, 5, 0, 2)
+	game.players[0].tiles.Push(Tile{Value: 6, Worms: 2})
+
+	_, _ = game.Roll()
+	_ = game.Pick(Bread)
+	_, _ = game.Roll()
+	_ = game.Pick(Worm)
+	_, _ = game.Roll()
+
+	if game.Phase() != Busted {
+		t.Fatalf("Phase() after an unpickable roll = %v, want %v", game.Phase(), Busted)
+	}
+
+	if err := game.Pick(Worm); !errors.Is(err, ErrIllegalAction) {
+		t.Errorf("Pick() after busting error = %v, want %v", err, ErrIllegalAction)
+	}
+
+	if _, err := game.Roll(); !errors.Is(err, ErrIllegalAction) {
+		t.Errorf("Roll() after busting error = %v, want %v", err, ErrIllegalAction)
+	}
+
+	if err := game.StopTurn(); !errors.Is(err, ErrIllegalAction) {
+		t.Errorf("StopTurn() after busting error = %v, want %v", err, ErrIllegalAction)
+	}
+
+	if game.players[0].tiles.Len() != 0 {
+		t.Errorf("Busted player should have lost their top tile")
+	}
+}
+
+func TestGameActionsWhenNotPlaying(t *testing.T) {
+	game := NewGame(DefaultRules, 1)
+
+	if _, err := game.Roll(); !errors.Is(err, ErrGameOver) {
+		t.Errorf("Roll() before start error = %v, want %v", err, ErrGameOver)
+	}
+	if err := game.Pick(Worm); !errors.Is(err, ErrGameOver) {
+		t.Errorf("Pick() before start error = %v, want %v", err, ErrGameOver)
+	}
+	if err := game.StopTurn(); !errors.Is(err, ErrGameOver) {
+		t.Errorf("StopTurn() before start error = %v, want %v", err, ErrGameOver)
+	}
+}
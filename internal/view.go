@@ -0,0 +1,173 @@
+package internal
+
+import (
+	"maps"
+	"slices"
+
+	"regenwormen/pkg/utils"
+)
+
+// GameView is a read-only copy of the game, as seen by the current player when taking a decision.
+// Changing it has no effect on the game it was taken from.
+type GameView struct {
+	Rules  RuleSet   `json:"rules"`
+	Turn   int       `json:"turn"` // seat of the current player, from 0
+	TurnNr int       `json:"turnNr"`
+	Phase  TurnPhase `json:"phase"`
+	Board  BoardView `json:"board"`
+	Stacks [][]Tile  `json:"stacks"` // tiles of every player by seat, top tile first
+	Roll   []Symbol  `json:"roll"`
+	Picked []Symbol  `json:"picked"`
+}
+
+// BoardView lists the tiles on the board, lowest first
+type BoardView struct {
+	FaceUp   []Tile `json:"faceUp"`
+	FaceDown []Tile `json:"faceDown"`
+	Min      int    `json:"min"`
+	Max      int    `json:"max"`
+}
+
+// View captures what the current player can see of the game
+func (g *Game) View() GameView {
+	v := GameView{
+		Rules: RuleSet{
+			Name:       g.rules.Name,
+			DiceCount:  g.rules.DiceCount,
+			Faces:      slices.Clone(g.rules.Faces),
+			Points:     maps.Clone(g.rules.Points),
+			Tiles:      slices.Clone(g.rules.Tiles),
+			MinPlayers: g.rules.MinPlayers,
+			MaxPlayers: g.rules.MaxPlayers,
+		},
+		Turn:   g.turn,
+		TurnNr: g.turnNr,
+		Phase:  g.phase,
+		Board:  BoardView{Min: g.board.min, Max: g.board.max},
+		Roll:   slices.Clone(g.dice.roll),
+		Picked: slices.Clone(g.dice.picked),
+	}
+
+	for i := g.board.min; i <= g.board.max; i++ {
+		v.Board.FaceUp = append(v.Board.FaceUp, g.board.tiles[i]...)
+		v.Board.FaceDown = append(v.Board.FaceDown, g.board.faceDown[i]...)
+	}
+
+	for _, p := range g.players {
+		v.Stacks = append(v.Stacks, p.Scorecard().Tiles)
+	}
+
+	return v
+}
+
+// Tile returns the face up tile with the given value, if it is on the board
+func (b BoardView) Tile(value int) (Tile, bool) {
+	for _, t := range b.FaceUp {
+		if t.Value == value {
+			return t, true
+		}
+	}
+
+	return Tile{}, false
+}
+
+// TileFor returns the face up tile with the given value, else the closest lower one
+func (b BoardView) TileFor(score int) (Tile, bool) {
+	for i := score; i >= b.Min; i-- {
+		if t, ok := b.Tile(i); ok {
+			return t, true
+		}
+	}
+
+	return Tile{}, false
+}
+
+// Points returns how many points every die showing the symbol is worth
+func (v GameView) Points(s Symbol) int {
+	return v.Rules.Points[s]
+}
+
+// PickedScore sums the points of the picked dice, and tells whether a worm is among them
+func (v GameView) PickedScore() (score int, hasWorm bool) {
+	for _, p := range v.Picked {
+		score += v.Points(p)
+	}
+
+	return score, slices.Contains(v.Picked, Worm)
+}
+
+// DiceLeft counts the dice not picked yet
+func (v GameView) DiceLeft() int {
+	return v.Rules.DiceCount - len(v.Picked)
+}
+
+// CanPick tells whether the symbol is in the roll and was not picked before
+func (v GameView) CanPick(s Symbol) bool {
+	return slices.Contains(v.Roll, s) && !slices.Contains(v.Picked, s)
+}
+
+// CountInRoll counts the dice of the roll showing the symbol
+func (v GameView) CountInRoll(s Symbol) int {
+	return utils.CountOccurrences(v.Roll, func(r Symbol) bool { return r == s })
+}
+
+// TopTile returns the top tile of the player in the given seat
+func (v GameView) TopTile(seat int) (Tile, bool) {
+	if seat < 0 || seat >= len(v.Stacks) || len(v.Stacks[seat]) == 0 {
+		return Tile{}, false
+	}
+
+	return v.Stacks[seat][0], true
+}
+
+// TileFor tells which tile the current player gets by ending the turn with the score, as the game resolves it:
+// the tile of that value from the board, else the top tile of that value of an opponent, else the closest lower
+// tile from the board. From is the seat of the robbed opponent, -1 for tiles from the board.
+func (v GameView) TileFor(score int) (t Tile, from int, ok bool) {
+	if t, ok = v.Board.Tile(score); ok {
+		return t, -1, true
+	}
+
+	for i := range v.Stacks {
+		if top, exists := v.TopTile(i); i != v.Turn && exists && top.Value == score {
+			return top, i, true
+		}
+	}
+
+	t, ok = v.Board.TileFor(score)
+
+	return t, -1, ok
+}
+
+// Simulate creates a new game in the viewed position, with dice thrown by the roller, to try decisions out.
+// The players of the new game have no strategy and no history.
+func (v GameView) Simulate(roller Roller) *Game {
+	g := &Game{
+		State:  GameLoop,
+		dice:   NewDice(v.Rules, roller),
+		rules:  v.Rules,
+		turn:   v.Turn,
+		turnNr: v.TurnNr,
+		phase:  v.Phase,
+	}
+	g.dice.roll = slices.Clone(v.Roll)
+	g.dice.picked = slices.Clone(v.Picked)
+
+	g.board = &Board{tiles: map[int][]Tile{}, faceDown: map[int][]Tile{}, min: v.Board.Min, max: v.Board.Max}
+	for _, t := range v.Board.FaceUp {
+		g.board.Put(t)
+	}
+	for _, t := range v.Board.FaceDown {
+		g.board.faceDown[t.Value] = append(g.board.faceDown[t.Value], t)
+	}
+
+	for _, stack := range v.Stacks {
+		p := Player{mode: AI, tiles: utils.NewStack[Tile]()}
+		for i := len(stack) - 1; i >= 0; i-- {
+			p.tiles.Push(stack[i])
+		}
+		g.players = append(g.players, p)
+	}
+
+	return g
+}
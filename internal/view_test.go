@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGameViewIsACopy(t *testing.T) {
+	game := newScriptedGame(0, 2, 2, 3, 4, 5)
+	_, _ = game.Roll()
+	_ = game.Pick(Bread)
+	game.players[1].tiles.Push(Tile{Value: 7, Worms: 3})
+
+	view := game.View()
+	want := game.View()
+
+	view.Rules.Points[Worm] = 100
+	view.Rules.Faces[0] = Cheese
+	view.Board.FaceUp[0].Worms = 100
+	view.Stacks[1][0].Value = 100
+	view.Picked[0] = Worm
+
+	if got := game.View(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Changing the view changed the game: %+v, want %+v", got, want)
+	}
+	if top, _ := game.players[1].tiles.Top(); top.Value != 7 {
+		t.Errorf("Changing the view changed the stack of P2: top %v", top)
+	}
+}
+
+func TestGameViewTileFor(t *testing.T) {
+	game := NewGame(DefaultRules, 1)
+	_ = game.Start(0, 3)
+
+	_, _ = game.board.Take(6)
+	_, _ = game.board.Take(6)
+	_, _ = game.board.Take(8)
+	_, _ = game.board.Take(8)
+	game.players[1].tiles.Push(Tile{Value: 8, Worms: 3})
+	game.players[0].tiles.Push(Tile{Value: 6, Worms: 2})
+	view := game.View()
+
+	tests := []struct {
+		score    int
+		want     Tile
+		wantFrom int
+		wantOk   bool
+	}{
+		{9, Tile{Value: 9, Worms: 4}, -1, true},
+		{8, Tile{Value: 8, Worms: 3}, 1, true},
+		{6, Tile{Value: 5, Worms: 1}, -1, true},
+		{3, Tile{}, -1, false},
+	}
+
+	for _, tt := range tests {
+		tile, from, ok := view.TileFor(tt.score)
+		if tile != tt.want || from != tt.wantFrom || ok != tt.wantOk {
+			t.Errorf("TileFor(%d) = %v, %d, %v, want %v, %d, %v", tt.score, tile, from, ok, tt.want, tt.wantFrom, tt.wantOk)
+		}
+	}
+}
+
+func TestGameViewSimulate(t *testing.T) {
+	game := NewGame(ClassicRules, 9)
+	_ = game.Start(0, 3)
+	playTurns(game, 5)
+	_, _ = game.Roll()
+
+	view := game.View()
+	sim := view.Simulate(NewSeededRoller(1))
+	if !reflect.DeepEqual(sim.View(), view) {
+		t.Errorf("Simulate().View() = %+v, want %+v", sim.View(), view)
+	}
+
+	playTurns(sim, 3)
+	if sim.View().TurnNr == view.TurnNr {
+		t.Fatalf("The simulated game should move on, still at turn %d", view.TurnNr)
+	}
+	if got := game.View(); !reflect.DeepEqual(got, view) {
+		t.Errorf("Playing the simulated game changed the original one: %+v, want %+v", got, view)
+	}
+}
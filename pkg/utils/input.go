@@ -47,3 +47,20 @@ func MustReadBool(reader *bufio.Reader, message, trueAnswer, falseAnswer string)
 
 	return
 }
+
+// MustReadChoice asks for one of the given choices. An answer matches a choice it is the prefix of.
+func MustReadChoice(reader *bufio.Reader, message string, choices ...string) (choice string, hasAnswer bool) {
+	s := strings.ToLower(strings.TrimSpace(MustReadString(reader, fmt.Sprintf("%s (%s) ", message, strings.Join(choices, "/")))))
+
+	if len(s) == 0 {
+		return
+	}
+
+	for _, c := range choices {
+		if strings.HasPrefix(strings.ToLower(c), s) {
+			return c, true
+		}
+	}
+
+	return
+}
@@ -41,3 +41,11 @@ func (stack *Stack[T]) IsEmpty() bool {
 func (s *Stack[T]) Len() int {
 	return len(s.keys)
 }
+
+// Items returns a copy of the stack content, from the bottom to the top
+func (s *Stack[T]) Items() []T {
+	items := make([]T, len(s.keys))
+	copy(items, s.keys)
+
+	return items
+}
@@ -37,3 +37,25 @@ func TestPushPop(t *testing.T) {
 		t.Fatal("stack.Pop() on empty stack return ok!")
 	}
 }
+
+func TestItems(t *testing.T) {
+	stk := NewStack[int]()
+	stk.Push(1)
+	stk.Push(2)
+
+	items := stk.Items()
+	if len(items) != 2 || items[0] != 1 || items[1] != 2 {
+		t.Fatal("stack.Items() not returning the content from bottom to top")
+	}
+
+	items[0] = 42
+	if stk.Len() != 2 {
+		t.Fatal("stack.Items() should not change the stack")
+	}
+	if z, _ := stk.Pop(); z != 2 {
+		t.Fatal("stack.Items() should return a copy")
+	}
+	if z, _ := stk.Pop(); z != 1 {
+		t.Fatal("stack.Items() should return a copy")
+	}
+}